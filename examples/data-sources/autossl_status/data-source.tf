@@ -0,0 +1,7 @@
+data "cpanel_autossl_status" "status" {}
+
+output "autossl_problems" {
+  value = {
+    for problem in data.cpanel_autossl_status.status.problems : problem.domain => problem.problem
+  }
+}
@@ -0,0 +1,5 @@
+data "cpanel_domains" "all" {}
+
+output "addon_document_roots" {
+  value = { for d in data.cpanel_domains.all.addon_domains : d.domain => d.document_root }
+}
@@ -0,0 +1,3 @@
+data "cpanel_mysql_user" "user" {
+  name = "sc1john1234_user"
+}
\ No newline at end of file
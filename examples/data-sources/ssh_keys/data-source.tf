@@ -0,0 +1,5 @@
+data "cpanel_ssh_keys" "all" {}
+
+output "authorized_fingerprints" {
+  value = { for k in data.cpanel_ssh_keys.all.keys : k.name => k.fingerprint if k.authorized }
+}
@@ -0,0 +1 @@
+terraform import cpanel_addon_domain.client client.example.net
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "cpanel_addon_domain" "client" {
+  domain        = "client.example.net"
+  document_root = "sites/client.example.net"
+}
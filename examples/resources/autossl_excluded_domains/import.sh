@@ -0,0 +1,2 @@
+# The identifier is the cPanel username of the account
+terraform import cpanel_autossl_excluded_domains.cdn john
\ No newline at end of file
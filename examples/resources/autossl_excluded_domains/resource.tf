@@ -0,0 +1,5 @@
+# Domains served through the CDN get their certificates from the CDN, not from AutoSSL
+resource "cpanel_autossl_excluded_domains" "cdn" {
+  domains         = ["cdn.example.com", "static.example.com"]
+  check_on_change = true
+}
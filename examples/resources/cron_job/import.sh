@@ -0,0 +1,4 @@
+# The cron job is identified by its line key, its command number or its full crontab line
+terraform import cpanel_cron_job.cron 3
+terraform import cpanel_cron_job.cron commandnumber:2
+terraform import cpanel_cron_job.cron "0 0 1 1 * ls -la"
\ No newline at end of file
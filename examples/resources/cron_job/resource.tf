@@ -5,4 +5,13 @@ resource "cpanel_cron_job" "cron" {
   day     = "1"
   weekday = "*"
   month   = "1"
-}
\ No newline at end of file
+}
+resource "cpanel_cron_job" "queue" {
+  command  = "/usr/local/bin/php artisan queue:work --stop-when-empty"
+  schedule = "*/5 * * * MON-FRI"
+}
+
+resource "cpanel_cron_job" "backup" {
+  command  = "/usr/local/bin/backup.sh"
+  schedule = "@daily"
+}
@@ -0,0 +1,2 @@
+# The identifier is the cPanel username of the account
+terraform import cpanel_cron_settings.settings john
\ No newline at end of file
@@ -0,0 +1,8 @@
+# Send the output of the cron jobs to the operations team, and run them with bash
+resource "cpanel_cron_settings" "settings" {
+  email = "ops@example.com"
+  variables = {
+    SHELL = "/bin/bash"
+    PATH  = "/usr/local/bin:/usr/bin:/bin"
+  }
+}
@@ -0,0 +1,3 @@
+# The identifier is zone/name/type, followed by /value when several records share the name and type
+terraform import cpanel_dns_record.app example.com/app/A
+terraform import cpanel_dns_record.mx example.com/@/MX/mx.example.net
\ No newline at end of file
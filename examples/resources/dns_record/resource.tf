@@ -0,0 +1,25 @@
+resource "cpanel_dns_record" "app" {
+  zone  = "example.com"
+  name  = "app"
+  type  = "A"
+  value = "192.0.2.10"
+}
+
+resource "cpanel_dns_record" "mx" {
+  zone     = "example.com"
+  name     = "@"
+  type     = "MX"
+  priority = 10
+  value    = "mx.example.net"
+}
+
+resource "cpanel_dns_record" "sip" {
+  zone     = "example.com"
+  name     = "_sip._tcp"
+  type     = "SRV"
+  ttl      = 3600
+  priority = 10
+  weight   = 5
+  port     = 5060
+  value    = "sip.example.com"
+}
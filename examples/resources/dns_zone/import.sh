@@ -0,0 +1 @@
+terraform import cpanel_dns_zone.example example.com
\ No newline at end of file
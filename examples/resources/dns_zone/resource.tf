@@ -0,0 +1,10 @@
+resource "cpanel_dns_zone" "example" {
+  zone = "example.com"
+
+  records = [
+    { name = "@", type = "A", value = "192.0.2.1" },
+    { name = "www", type = "CNAME", value = "example.com" },
+    { name = "@", type = "MX", priority = 10, value = "mx.example.net" },
+    { name = "@", type = "TXT", ttl = 3600, value = "v=spf1 mx -all" },
+  ]
+}
@@ -0,0 +1 @@
+terraform import cpanel_domain_alias.example_org example.org
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "cpanel_domain_alias" "example_org" {
+  domain = "example.org"
+}
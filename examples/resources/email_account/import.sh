@@ -0,0 +1 @@
+terraform import cpanel_email_account.john john@example.com
\ No newline at end of file
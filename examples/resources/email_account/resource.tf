@@ -0,0 +1,6 @@
+resource "cpanel_email_account" "john" {
+  email    = "john"
+  domain   = "example.com"
+  password = "password"
+  quota_mb = 1024
+}
@@ -0,0 +1 @@
+terraform import cpanel_email_domain_forwarder.example example.net
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "cpanel_email_domain_forwarder" "example" {
+  domain             = "example.net"
+  destination_domain = "example.com"
+}
@@ -0,0 +1,4 @@
+# The identifier is the forwarded address and the destination, separated by a slash
+terraform import cpanel_email_forwarder.sales sales@example.com/john@example.com
+terraform import cpanel_email_forwarder.noreply "noreply@example.com/:fail: This address does not receive messages."
+terraform import cpanel_email_forwarder.spam spam@example.com/:blackhole:
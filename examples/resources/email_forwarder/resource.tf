@@ -0,0 +1,25 @@
+resource "cpanel_email_forwarder" "sales" {
+  email       = "sales"
+  domain      = "example.com"
+  destination = "john@example.com"
+}
+
+resource "cpanel_email_forwarder" "tickets" {
+  email       = "tickets"
+  domain      = "example.com"
+  action      = "pipe"
+  destination = "bin/tickets.php"
+}
+
+resource "cpanel_email_forwarder" "noreply" {
+  email        = "noreply"
+  domain       = "example.com"
+  action       = "fail"
+  fail_message = "This address does not receive messages."
+}
+
+resource "cpanel_email_forwarder" "spam" {
+  email  = "spam"
+  domain = "example.com"
+  action = "blackhole"
+}
@@ -0,0 +1 @@
+terraform import cpanel_ftp_account.agency agency@example.com
\ No newline at end of file
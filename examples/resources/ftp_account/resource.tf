@@ -0,0 +1,12 @@
+variable "agency_ftp_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "cpanel_ftp_account" "agency" {
+  user     = "agency"
+  domain   = "example.com"
+  password = var.agency_ftp_password
+  homedir  = "public_html/uploads"
+  quota    = 1024
+}
@@ -0,0 +1 @@
+terraform import cpanel_mysql_database.database sc1john1234_database
\ No newline at end of file
@@ -0,0 +1,3 @@
+resource "cpanel_mysql_database" "database" {
+  name = "sc1john1234_database"
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import cpanel_mysql_database_privileges.reporting sc1john1234_database/sc1john1234_reporting
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "cpanel_mysql_database_privileges" "reporting" {
+  database   = "sc1john1234_database"
+  user       = "sc1john1234_reporting"
+  privileges = ["SELECT", "SHOW VIEW"]
+}
+
+resource "cpanel_mysql_database_privileges" "application" {
+  database   = "sc1john1234_database"
+  user       = "sc1john1234_user"
+  privileges = ["ALL PRIVILEGES"]
+}
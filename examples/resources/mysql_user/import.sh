@@ -0,0 +1 @@
+terraform import cpanel_mysql_user.user sc1john1234_user
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "cpanel_mysql_user" "user" {
+  name     = "sc1john1234_user"
+  password = "password"
+}
\ No newline at end of file
@@ -1,4 +1,3 @@
 resource "cpanel_postgresql_database" "database" {
-  name  = "sc1john1234_database"
-  users = ["sc1john1234_user"]
+  name = "sc1john1234_database"
 }
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import cpanel_postgresql_database_grant.grant sc1john1234_database/sc1john1234_user
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "cpanel_postgresql_database_grant" "grant" {
+  database = cpanel_postgresql_database.database.name
+  user     = cpanel_postgresql_user.user.name
+}
@@ -0,0 +1,2 @@
+# The key is identified by its name
+terraform import cpanel_ssh_key.deploy deploy
\ No newline at end of file
@@ -0,0 +1,11 @@
+resource "cpanel_ssh_key" "deploy" {
+  name       = "deploy"
+  public_key = file("${path.module}/deploy.pub")
+}
+
+# A key kept on the account but no longer allowed to log in
+resource "cpanel_ssh_key" "former_contractor" {
+  name       = "former-contractor"
+  public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKcuGS9YJEojAD7PJ4guO2rXKhm4gz6QC69uVwXiGhF0 contractor@example.com"
+  authorized = false
+}
@@ -0,0 +1 @@
+terraform import cpanel_ssl_certificate.example example.com
\ No newline at end of file
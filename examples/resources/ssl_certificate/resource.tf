@@ -0,0 +1,6 @@
+resource "cpanel_ssl_certificate" "example" {
+  domain      = "example.com"
+  certificate = file("${path.module}/example.com.crt")
+  private_key = file("${path.module}/example.com.key")
+  ca_bundle   = file("${path.module}/example.com.ca-bundle")
+}
@@ -0,0 +1,2 @@
+# The identifier is the one of the request in the SSL/TLS manager, its key being found among the keys of the account
+terraform import cpanel_ssl_csr.example example_com_a1b2c_d3e4f_1ab2c3d4e5f6
\ No newline at end of file
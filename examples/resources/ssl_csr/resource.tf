@@ -0,0 +1,13 @@
+resource "cpanel_ssl_key" "example" {
+  key_type = "rsa-2048"
+}
+
+resource "cpanel_ssl_csr" "example" {
+  key_id                 = cpanel_ssl_key.example.id
+  domains                = ["example.com", "www.example.com"]
+  country_name           = "FR"
+  state_or_province_name = "Ile-de-France"
+  locality_name          = "Paris"
+  organization_name      = "Example"
+  email_address          = "admin@example.com"
+}
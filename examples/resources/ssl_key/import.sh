@@ -0,0 +1,2 @@
+# The identifier is the one of the key in the SSL/TLS manager
+terraform import cpanel_ssl_key.example a1b2c_d3e4f_2048_1ab2c3d4e5f6
\ No newline at end of file
@@ -0,0 +1,4 @@
+resource "cpanel_ssl_key" "example" {
+  friendly_name = "example.com"
+  key_type      = "ecdsa-prime256v1"
+}
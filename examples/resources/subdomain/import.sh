@@ -0,0 +1 @@
+terraform import cpanel_subdomain.blog blog.example.com
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "cpanel_subdomain" "blog" {
+  subdomain     = "blog"
+  domain        = "example.com"
+  document_root = "public_html/blog"
+}
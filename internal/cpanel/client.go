@@ -2,16 +2,21 @@ package cpanel
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 )
 
 type Client struct {
-	HTTPClient *http.Client
-	HostURL    string
-	Auth       AuthStruct
+	HTTPClient   *http.Client
+	HostURL      string
+	Auth         AuthStruct
+	MaxRetries   int
+	RetryMaxWait time.Duration
 }
 
 type AuthStruct struct {
@@ -19,14 +24,23 @@ type AuthStruct struct {
 	ApiToken string `json:"api_token"`
 }
 
-func NewClient(host, username, apiToken *string) (*Client, error) {
+func NewClient(host, username, apiToken *string, options ...ClientOption) (*Client, error) {
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient: &http.Client{Timeout: DefaultRequestTimeout},
 		HostURL:    *host,
 		Auth: AuthStruct{
 			Username: *username,
 			ApiToken: *apiToken,
 		},
+		MaxRetries:   DefaultMaxRetries,
+		RetryMaxWait: DefaultRetryMaxWait,
+	}
+
+	for _, option := range options {
+		err := option(&c)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &c, nil
@@ -35,6 +49,30 @@ func NewClient(host, username, apiToken *string) (*Client, error) {
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	req.Header.Set("Authorization", fmt.Sprintf("%s %s:%s", "cpanel", c.Auth.Username, c.Auth.ApiToken))
 
+	for attempt := 0; ; attempt++ {
+		body, err := c.doRequestOnce(req)
+		if err == nil || attempt >= c.MaxRetries || !shouldRetry(req, err) {
+			return body, err
+		}
+
+		time.Sleep(c.retryWait(attempt, err))
+
+		// Rewind the request body for the next attempt
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (c *Client) doRequestOnce(req *http.Request) ([]byte, error) {
+	// Wait for the previous request on this account to complete
+	queue := requestQueue(c.HostURL, c.Auth.Username)
+	queue.Lock()
+	defer queue.Unlock()
+
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, err
@@ -52,50 +90,88 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	}
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, &StatusError{
+			StatusCode: res.StatusCode,
+			Body:       string(body),
+			RetryAfter: parseRetryAfter(res.Header.Get("Retry-After")),
+		}
 	}
 
 	return body, err
 }
 
-func (c *Client) ExecuteUAPIOperation(module, function string, queryParams map[string]string, inputModel interface{}) error {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/execute/%s/%s", c.HostURL, module, function), nil)
+func (c *Client) ExecuteUAPIOperation(module string, operation Operation, params map[string]string, inputModel interface{}) error {
+	req, err := newRequest(operation.Method, fmt.Sprintf("%s/execute/%s/%s", c.HostURL, module, operation.Function), params)
 	if err != nil {
 		return err
 	}
 
-	q := req.URL.Query()
-	for key, value := range queryParams {
-		q.Add(key, value)
-	}
-	req.URL.RawQuery = q.Encode()
+	return c.execute(req, inputModel, func(httpStatus int, body []byte) error {
+		return checkUAPIResult(module, operation.Function, httpStatus, body)
+	})
+}
 
-	body, err := c.doRequest(req)
+func (c *Client) ExecuteAPI2Operation(module string, operation Operation, params map[string]string, inputModel interface{}) error {
+	req, err := newRequest(operation.Method, fmt.Sprintf("%s/json-api/cpanel?cpanel_jsonapi_apiversion=2&cpanel_jsonapi_user=%s&cpanel_jsonapi_module=%s&cpanel_jsonapi_func=%s", c.HostURL, url.QueryEscape(c.Auth.Username), module, operation.Function), params)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(body, inputModel)
+	return c.execute(req, inputModel, func(httpStatus int, body []byte) error {
+		return checkAPI2Result(module, operation.Function, httpStatus, body)
+	})
+}
+
+// newRequest builds a request sending params in the query string for GET
+// requests, and as a form-encoded body otherwise.
+func newRequest(method, requestURL string, params map[string]string) (*http.Request, error) {
+	if method == http.MethodGet {
+		req, err := http.NewRequest(method, requestURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		q := req.URL.Query()
+		for key, value := range params {
+			q.Add(key, value)
+		}
+		req.URL.RawQuery = q.Encode()
+
+		return req, nil
+	}
+
+	values := url.Values{}
+	for key, value := range params {
+		values.Add(key, value)
+	}
+
+	req, err := http.NewRequest(method, requestURL, strings.NewReader(values.Encode()))
 	if err != nil {
-		return err
+		return nil, err
 	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
-	return nil
+	return req, nil
 }
 
-func (c *Client) ExecuteAPI2Operation(module, function string, queryParams map[string]string, inputModel interface{}) error {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/json-api/cpanel?cpanel_jsonapi_apiversion=2&cpanel_jsonapi_user=%s&cpanel_jsonapi_module=%s&cpanel_jsonapi_func=%s", c.HostURL, c.Auth.Username, module, function), nil)
+// execute sends the request, checks the response with checkResult and decodes it into inputModel.
+func (c *Client) execute(req *http.Request, inputModel interface{}, checkResult func(httpStatus int, body []byte) error) error {
+	body, err := c.doRequest(req)
 	if err != nil {
+		// cPanel sometimes answers with an error status and a regular result body
+		var statusError *StatusError
+		if errors.As(err, &statusError) {
+			var uapiError *UAPIError
+			var api2Error *API2Error
+			resultErr := checkResult(statusError.StatusCode, []byte(statusError.Body))
+			if errors.As(resultErr, &uapiError) || errors.As(resultErr, &api2Error) {
+				return resultErr
+			}
+		}
 		return err
 	}
 
-	q := req.URL.Query()
-	for key, value := range queryParams {
-		q.Add(key, value)
-	}
-	req.URL.RawQuery = q.Encode()
-
-	body, err := c.doRequest(req)
+	err = checkResult(http.StatusOK, body)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,315 @@
+package cpanel
+
+import (
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientSerializesRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			previous := atomic.LoadInt32(&maxInFlight)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxInFlight, previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"status":1}`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+
+	// Two clients on the same account must share the same queue
+	clients := make([]*Client, 2)
+	for i := range clients {
+		client, err := NewClient(&host, &username, &apiToken)
+		if err != nil {
+			t.Fatal(err)
+		}
+		clients[i] = client
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(client *Client) {
+			defer wg.Done()
+			var result UAPIDataSourceModel
+			if err := client.ExecuteUAPIOperation(ModulePostgresql, ReadOperation("list_databases"), map[string]string{}, &result); err != nil {
+				t.Error(err)
+			}
+		}(clients[i%len(clients)])
+	}
+	wg.Wait()
+
+	if maxInFlight != 1 {
+		t.Fatalf("expected requests to be serialized, got %d concurrent requests", maxInFlight)
+	}
+}
+
+func TestClientRetriesTransientErrors(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":1}`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken, WithRetry(3, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result UAPIDataSourceModel
+	if err := client.ExecuteUAPIOperation(ModulePostgresql, ReadOperation("list_databases"), map[string]string{}, &result); err != nil {
+		t.Fatalf("expected the request to succeed after retries, got: %s", err)
+	}
+
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestClientDoesNotRetryPermanentErrors(t *testing.T) {
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken, WithRetry(3, time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result UAPIDataSourceModel
+	err = client.ExecuteUAPIOperation(ModulePostgresql, ReadOperation("list_databases"), map[string]string{}, &result)
+
+	var statusError *StatusError
+	if !errors.As(err, &statusError) || statusError.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected a 500 status error, got: %v", err)
+	}
+
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestClientReturnsUAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":0,"errors":["The database “user_db” does not exist."],"messages":null,"warnings":["deprecated"]}`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result UAPIDataSourceModel
+	err = client.ExecuteUAPIOperation(ModulePostgresql, WriteOperation("delete_database"), map[string]string{"name": "user_db"}, &result)
+
+	var uapiError *UAPIError
+	if !errors.As(err, &uapiError) {
+		t.Fatalf("expected a *UAPIError, got: %v", err)
+	}
+	if uapiError.Module != ModulePostgresql || uapiError.Function != "delete_database" || uapiError.HTTPStatus != http.StatusOK {
+		t.Fatalf("unexpected error details: %+v", uapiError)
+	}
+	if len(uapiError.Warnings) != 1 {
+		t.Fatalf("expected warnings to be kept, got: %+v", uapiError.Warnings)
+	}
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("expected the error to only match ErrNotFound: %s", err)
+	}
+}
+
+func TestClientReturnsAPI2Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"cpanelresult":{"module":"Cron","func":"remove_line","event":{"result":1},"warnings":["deprecated"],"data":[{"status":0,"statusmsg":"Invalid line key"}]}}`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result API2DataSourceCpanelResultModel
+	err = client.ExecuteAPI2Operation(ModuleCron, WriteOperation("remove_line"), map[string]string{"linekey": "1"}, &result)
+
+	var api2Error *API2Error
+	if !errors.As(err, &api2Error) {
+		t.Fatalf("expected an *API2Error, got: %v", err)
+	}
+	if api2Error.Module != ModuleCron || api2Error.Function != "remove_line" || len(api2Error.Messages) != 1 {
+		t.Fatalf("unexpected error details: %+v", api2Error)
+	}
+	if len(api2Error.Warnings) != 1 {
+		t.Fatalf("expected warnings to be kept, got: %+v", api2Error.Warnings)
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected the error to match ErrNotFound: %s", err)
+	}
+}
+
+func TestClientReturnsAPI2ResultError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"cpanelresult":{"module":"Park","func":"park","event":{"result":1},"data":[{"result":0,"reason":"The domain “example.net” already exists."}]}}`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result API2DataSourceCpanelResultModel
+	err = client.ExecuteAPI2Operation("Park", WriteOperation("park"), map[string]string{"domain": "example.net"}, &result)
+
+	var api2Error *API2Error
+	if !errors.As(err, &api2Error) {
+		t.Fatalf("expected an *API2Error, got: %v", err)
+	}
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Fatalf("expected the error to match ErrAlreadyExists: %s", err)
+	}
+}
+
+func TestClientReturnsAPI2ResultErrorWithoutReason(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"cpanelresult":{"module":"AddonDomain","func":"addaddondomain","event":{"result":1},"data":[{"result":0}]}}`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result API2DataSourceCpanelResultModel
+	err = client.ExecuteAPI2Operation("AddonDomain", WriteOperation("addaddondomain"), map[string]string{"newdomain": "example.net"}, &result)
+
+	var api2Error *API2Error
+	if !errors.As(err, &api2Error) {
+		t.Fatalf("expected an *API2Error, got: %v", err)
+	}
+	if len(api2Error.Messages) != 1 || api2Error.Messages[0] != "the API2 function returned a failed result" {
+		t.Fatalf("unexpected error messages: %q", api2Error.Messages)
+	}
+}
+
+func TestClientReturnsAuthError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`Access denied`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result UAPIDataSourceModel
+	err = client.ExecuteUAPIOperation(ModulePostgresql, ReadOperation("list_databases"), map[string]string{}, &result)
+	if !errors.Is(err, ErrAuth) {
+		t.Fatalf("expected the error to match ErrAuth, got: %v", err)
+	}
+}
+
+func TestClientTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"status":1}`))
+	}))
+	defer server.Close()
+
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	testCases := map[string]struct {
+		options     TLSOptions
+		expectError bool
+	}{
+		"untrusted":   {options: TLSOptions{}, expectError: true},
+		"custom CA":   {options: TLSOptions{CACertPEM: caCertPEM}},
+		"insecure":    {options: TLSOptions{InsecureSkipVerify: true}},
+		"invalid CA":  {options: TLSOptions{CACertPEM: []byte("invalid")}, expectError: true},
+		"invalid key": {options: TLSOptions{ClientCertPEM: caCertPEM, ClientKeyPEM: []byte("invalid")}, expectError: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			host, username, apiToken := server.URL, "user", "token"
+			client, err := NewClient(&host, &username, &apiToken, WithRetry(0, time.Millisecond), WithTLS(testCase.options))
+			if err == nil {
+				var result UAPIDataSourceModel
+				err = client.ExecuteUAPIOperation(ModulePostgresql, ReadOperation("list_databases"), map[string]string{}, &result)
+			}
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestClientSendsWriteOperationsAsPOST(t *testing.T) {
+	var method, query, password string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		query = r.URL.RawQuery
+		password = r.PostFormValue("password")
+		_, _ = w.Write([]byte(`{"status":1}`))
+	}))
+	defer server.Close()
+
+	host, username, apiToken := server.URL, "user", "token"
+	client, err := NewClient(&host, &username, &apiToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result UAPIDataSourceModel
+	err = client.ExecuteUAPIOperation(ModulePostgresql, WriteOperation("create_user"), map[string]string{"name": "user", "password": "secret"}, &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if method != http.MethodPost || password != "secret" {
+		t.Fatalf("expected the password to be sent in a POST body, got %s with password %q", method, password)
+	}
+	if strings.Contains(query, "secret") {
+		t.Fatalf("expected the password not to be sent in the query string, got: %s", query)
+	}
+}
@@ -0,0 +1,127 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"time"
+)
+
+// AutoSSLProblem is a problem reported by the last AutoSSL check of the fake server.
+type AutoSSLProblem struct {
+	Domain  string
+	Problem string
+	Time    time.Time
+}
+
+// ExcludeAutoSSLDomain excludes a domain from AutoSSL, as if it was done outside Terraform.
+func (s *Server) ExcludeAutoSSLDomain(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.autoSSLExcludedDomains[domain] = true
+}
+
+// IncludeAutoSSLDomain removes a domain from the AutoSSL exclusions, as if it was done outside Terraform.
+func (s *Server) IncludeAutoSSLDomain(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.autoSSLExcludedDomains, domain)
+}
+
+// AutoSSLExcludedDomains returns the domains excluded from AutoSSL, sorted.
+func (s *Server) AutoSSLExcludedDomains() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedKeys(s.autoSSLExcludedDomains)
+}
+
+// AddAutoSSLProblem reports a problem as if it was found by the last AutoSSL check.
+func (s *Server) AddAutoSSLProblem(problem AutoSSLProblem) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.autoSSLProblems = append(s.autoSSLProblems, problem)
+}
+
+// SetAutoSSLCheckInProgress sets whether an AutoSSL check is running.
+func (s *Server) SetAutoSSLCheckInProgress(inProgress bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.autoSSLCheckInProgress = inProgress
+}
+
+func (s *Server) registerAutoSSL() {
+	s.register(apiUAPI, cpanel.ModuleSSL, "get_autossl_excluded_domains", "GET", s.sslGetAutoSSLExcludedDomains)
+	s.register(apiUAPI, cpanel.ModuleSSL, "add_autossl_excluded_domains", "POST", s.sslAddAutoSSLExcludedDomains)
+	s.register(apiUAPI, cpanel.ModuleSSL, "remove_autossl_excluded_domains", "POST", s.sslRemoveAutoSSLExcludedDomains)
+	s.register(apiUAPI, cpanel.ModuleSSL, "get_autossl_problems", "GET", s.sslGetAutoSSLProblems)
+	s.register(apiUAPI, cpanel.ModuleSSL, "is_autossl_check_in_progress", "GET", s.sslIsAutoSSLCheckInProgress)
+	s.register(apiUAPI, cpanel.ModuleSSL, "start_autossl_check", "POST", s.sslStartAutoSSLCheck)
+}
+
+func (s *Server) sslGetAutoSSLExcludedDomains(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, domain := range sortedKeys(s.autoSSLExcludedDomains) {
+		data = append(data, map[string]interface{}{"excluded_domain": domain})
+	}
+
+	return data, nil
+}
+
+func (s *Server) sslAddAutoSSLExcludedDomains(params url.Values) (interface{}, error) {
+	domains := strings.Split(params.Get("domains"), ",")
+
+	// Every domain is checked before any of them is excluded
+	for _, domain := range domains {
+		if _, subdomain := s.subdomains[domain]; !s.domainExists(domain) && !subdomain {
+			return nil, errorf("The domain “%s” does not exist on this account.", domain)
+		}
+	}
+
+	for _, domain := range domains {
+		s.autoSSLExcludedDomains[domain] = true
+	}
+
+	return nil, nil
+}
+
+func (s *Server) sslRemoveAutoSSLExcludedDomains(params url.Values) (interface{}, error) {
+	for _, domain := range strings.Split(params.Get("domains"), ",") {
+		delete(s.autoSSLExcludedDomains, domain)
+	}
+
+	return nil, nil
+}
+
+func (s *Server) sslGetAutoSSLProblems(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, problem := range s.autoSSLProblems {
+		data = append(data, map[string]interface{}{
+			"domain":  problem.Domain,
+			"problem": problem.Problem,
+			"time":    problem.Time.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) sslIsAutoSSLCheckInProgress(_ url.Values) (interface{}, error) {
+	return boolToInt(s.autoSSLCheckInProgress), nil
+}
+
+func (s *Server) sslStartAutoSSLCheck(_ url.Values) (interface{}, error) {
+	if s.autoSSLCheckInProgress {
+		return nil, errorf("An AutoSSL check is already in progress.")
+	}
+
+	s.autoSSLCheckInProgress = true
+
+	return nil, nil
+}
@@ -0,0 +1,285 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// cronVariablePattern matches the names of the variables of the crontab.
+var cronVariablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// CronJob is a command line of the fake crontab.
+type CronJob struct {
+	LineKey int64
+	Minute  string
+	Hour    string
+	Day     string
+	Month   string
+	Weekday string
+	Command string
+}
+
+// cronLine is a command line, or a variable line such as SHELL=/bin/bash when key is set.
+type cronLine struct {
+	job   CronJob
+	key   string
+	value string
+}
+
+// AddCronJob appends a command line to the crontab and returns its line key.
+func (s *Server) AddCronJob(job CronJob) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cronLines = append(s.cronLines, cronLine{job: job})
+
+	return int64(len(s.cronLines))
+}
+
+// RemoveCronLine removes a line from the crontab, shifting the following line keys.
+func (s *Server) RemoveCronLine(lineKey int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lineKey >= 1 && lineKey <= int64(len(s.cronLines)) {
+		s.cronLines = append(s.cronLines[:lineKey-1], s.cronLines[lineKey:]...)
+	}
+}
+
+// EditCronJob replaces a command line of the crontab, as if it was edited outside Terraform.
+func (s *Server) EditCronJob(lineKey int64, job CronJob) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lineKey >= 1 && lineKey <= int64(len(s.cronLines)) {
+		s.cronLines[lineKey-1].job = job
+	}
+}
+
+// CronJobs returns the command lines of the crontab.
+func (s *Server) CronJobs() []CronJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var jobs []CronJob
+	for i, line := range s.cronLines {
+		if line.key != "" {
+			continue
+		}
+
+		job := line.job
+		job.LineKey = int64(i + 1)
+		jobs = append(jobs, job)
+	}
+
+	return jobs
+}
+
+// SetCronVariable sets a variable of the crontab, such as PATH, as if it was done outside Terraform.
+// New variables are added before the command lines, shifting their line keys.
+func (s *Server) SetCronVariable(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.setCronVariable(key, value)
+}
+
+// CronVariables returns the variables of the crontab by name, including MAILTO.
+func (s *Server) CronVariables() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	variables := map[string]string{}
+	for _, line := range s.cronLines {
+		if line.key != "" {
+			variables[line.key] = line.value
+		}
+	}
+
+	return variables
+}
+
+func (s *Server) registerCron() {
+	s.register(apiAPI2, cpanel.ModuleCron, "fetchcron", "GET", s.cronFetch)
+	s.register(apiAPI2, cpanel.ModuleCron, "add_line", "POST", s.cronAddLine)
+	s.register(apiAPI2, cpanel.ModuleCron, "edit_line", "POST", s.cronEditLine)
+	s.register(apiAPI2, cpanel.ModuleCron, "remove_line", "POST", s.cronRemoveLine)
+	s.register(apiAPI2, cpanel.ModuleCron, "get_email", "GET", s.cronGetEmail)
+	s.register(apiAPI2, cpanel.ModuleCron, "set_email", "POST", s.cronSetEmail)
+}
+
+func (s *Server) cronFetch(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+	commandNumber := 0
+
+	for i, line := range s.cronLines {
+		if line.key != "" {
+			data = append(data, map[string]interface{}{
+				"linekey": i + 1,
+				"type":    "variable",
+				"key":     line.key,
+				"value":   line.value,
+			})
+			continue
+		}
+
+		commandNumber++
+		data = append(data, map[string]interface{}{
+			"linekey":          i + 1,
+			"commandnumber":    commandNumber,
+			"type":             "command",
+			"minute":           line.job.Minute,
+			"hour":             line.job.Hour,
+			"day":              line.job.Day,
+			"month":            line.job.Month,
+			"weekday":          line.job.Weekday,
+			"command":          line.job.Command,
+			"command_htmlsafe": line.job.Command,
+		})
+	}
+
+	data = append(data, map[string]interface{}{"count": strconv.Itoa(commandNumber)})
+
+	return data, nil
+}
+
+func (s *Server) cronAddLine(params url.Values) (interface{}, error) {
+	if params.Has("key") {
+		key, err := cronVariableFromParams(params)
+		if err != nil {
+			return nil, err
+		}
+
+		s.cronLines = append([]cronLine{{key: key, value: params.Get("value")}}, s.cronLines...)
+
+		return cronStatus(1), nil
+	}
+
+	job, err := cronJobFromParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cronLines = append(s.cronLines, cronLine{job: job})
+
+	return cronStatus(int64(len(s.cronLines))), nil
+}
+
+func (s *Server) cronEditLine(params url.Values) (interface{}, error) {
+	index, ok := s.cronLineIndex(params.Get("linekey"))
+	if !ok || (s.cronLines[index].key != "") != params.Has("key") {
+		return cronFailure("Invalid line key: " + params.Get("linekey")), nil
+	}
+
+	if params.Has("key") {
+		key, err := cronVariableFromParams(params)
+		if err != nil {
+			return nil, err
+		}
+
+		s.cronLines[index].key = key
+		s.cronLines[index].value = params.Get("value")
+
+		return cronStatus(int64(index + 1)), nil
+	}
+
+	job, err := cronJobFromParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cronLines[index].job = job
+
+	return cronStatus(int64(index + 1)), nil
+}
+
+func (s *Server) cronRemoveLine(params url.Values) (interface{}, error) {
+	index, ok := s.cronLineIndex(params.Get("linekey"))
+	if !ok {
+		return cronFailure("Invalid line key: " + params.Get("linekey")), nil
+	}
+
+	s.cronLines = append(s.cronLines[:index], s.cronLines[index+1:]...)
+
+	return []interface{}{map[string]interface{}{"status": 1, "statusmsg": "crontab installed"}}, nil
+}
+
+func (s *Server) cronGetEmail(_ url.Values) (interface{}, error) {
+	email := ""
+	for _, line := range s.cronLines {
+		if line.key == "MAILTO" {
+			email = line.value
+		}
+	}
+
+	return []interface{}{map[string]interface{}{"email": email}}, nil
+}
+
+func (s *Server) cronSetEmail(params url.Values) (interface{}, error) {
+	email := params.Get("email")
+	if email != "" && !strings.Contains(email, "@") {
+		return nil, errorf("“%s” is not a valid email address.", email)
+	}
+
+	s.setCronVariable("MAILTO", email)
+
+	return []interface{}{map[string]interface{}{"status": 1, "statusmsg": "email updated"}}, nil
+}
+
+// setCronVariable replaces the value of a variable, or inserts it before the other lines.
+func (s *Server) setCronVariable(key, value string) {
+	for i := range s.cronLines {
+		if s.cronLines[i].key == key {
+			s.cronLines[i].value = value
+			return
+		}
+	}
+
+	s.cronLines = append([]cronLine{{key: key, value: value}}, s.cronLines...)
+}
+
+func (s *Server) cronLineIndex(lineKey string) (int, bool) {
+	key, err := strconv.Atoi(lineKey)
+	if err != nil || key < 1 || key > len(s.cronLines) {
+		return 0, false
+	}
+
+	return key - 1, true
+}
+
+func cronJobFromParams(params url.Values) (CronJob, error) {
+	for _, name := range []string{"command", "minute", "hour", "day", "month", "weekday"} {
+		if params.Get(name) == "" {
+			return CronJob{}, errorf("The parameter “%s” is required.", name)
+		}
+	}
+
+	return CronJob{
+		Minute:  params.Get("minute"),
+		Hour:    params.Get("hour"),
+		Day:     params.Get("day"),
+		Month:   params.Get("month"),
+		Weekday: params.Get("weekday"),
+		Command: params.Get("command"),
+	}, nil
+}
+
+func cronVariableFromParams(params url.Values) (string, error) {
+	key := params.Get("key")
+	if !cronVariablePattern.MatchString(key) {
+		return "", errorf("“%s” is not a valid variable name.", key)
+	}
+
+	return key, nil
+}
+
+func cronStatus(lineKey int64) []interface{} {
+	return []interface{}{map[string]interface{}{"linekey": lineKey, "status": 1, "statusmsg": "crontab installed"}}
+}
+
+func cronFailure(message string) []interface{} {
+	return []interface{}{map[string]interface{}{"status": 0, "statusmsg": message}}
+}
@@ -0,0 +1,305 @@
+package cpaneltest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// DNSRecord is a resource record of a zone of the fake server. Name is kept as
+// written in the zone file, either relative to the zone or fully qualified.
+type DNSRecord struct {
+	LineIndex int64
+	Name      string
+	TTL       int64
+	Type      string
+	Data      []string
+}
+
+type dnsZone struct {
+	serial int64
+	lines  []dnsLine
+}
+
+// dnsLine is a line of a zone file, either a record, a comment or a control statement.
+type dnsLine struct {
+	kind   string
+	text   string
+	record DNSRecord
+}
+
+// AddDNSZone creates the zone of a domain, with the records cPanel adds to new domains.
+func (s *Server) AddDNSZone(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fqdn := domain + "."
+	zone := &dnsZone{serial: 2024010101}
+	zone.lines = []dnsLine{
+		{kind: "comment", text: "; cPanel first:11.118.0.0 latest:11.118.0.0"},
+		{kind: "control", text: "$TTL 14400"},
+		{kind: "record", record: DNSRecord{Name: fqdn, TTL: 86400, Type: "SOA", Data: []string{"ns1." + fqdn, "admin." + fqdn, strconv.FormatInt(zone.serial, 10), "3600", "1800", "1209600", "86400"}}},
+		{kind: "record", record: DNSRecord{Name: fqdn, TTL: 86400, Type: "NS", Data: []string{"ns1." + fqdn}}},
+		{kind: "record", record: DNSRecord{Name: fqdn, TTL: 86400, Type: "NS", Data: []string{"ns2." + fqdn}}},
+		{kind: "record", record: DNSRecord{Name: fqdn, TTL: 14400, Type: "A", Data: []string{"192.0.2.1"}}},
+		{kind: "record", record: DNSRecord{Name: "www", TTL: 14400, Type: "CNAME", Data: []string{fqdn}}},
+		{kind: "record", record: DNSRecord{Name: fqdn, TTL: 14400, Type: "MX", Data: []string{"0", fqdn}}},
+		{kind: "record", record: DNSRecord{Name: "mail", TTL: 14400, Type: "CNAME", Data: []string{fqdn}}},
+		{kind: "record", record: DNSRecord{Name: "cpanel", TTL: 14400, Type: "A", Data: []string{"192.0.2.1"}}},
+		{kind: "record", record: DNSRecord{Name: "webmail", TTL: 14400, Type: "A", Data: []string{"192.0.2.1"}}},
+		{kind: "record", record: DNSRecord{Name: "_cpanel-dcv-test-record", TTL: 300, Type: "TXT", Data: []string{"_cpanel-dcv-test-record=test"}}},
+	}
+
+	s.dnsZones[domain] = zone
+}
+
+// DNSRecords returns the records of a zone, in the order of the zone file.
+func (s *Server) DNSRecords(domain string) []DNSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone, ok := s.dnsZones[domain]
+	if !ok {
+		return nil
+	}
+
+	records := []DNSRecord{}
+	for i, line := range zone.lines {
+		if line.kind != "record" {
+			continue
+		}
+		record := line.record
+		record.LineIndex = int64(i)
+		record.Data = append([]string{}, record.Data...)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// AddDNSRecord appends a record to a zone, as if it was done outside Terraform.
+func (s *Server) AddDNSRecord(domain string, record DNSRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.dnsZones[domain]
+	zone.lines = append(zone.lines, dnsLine{kind: "record", record: record})
+	zone.bumpSerial()
+}
+
+// RemoveDNSRecords removes the records of a zone with the given name and type, as if
+// it was done outside Terraform.
+func (s *Server) RemoveDNSRecords(domain, name, recordType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.dnsZones[domain]
+	lines := []dnsLine{}
+	for _, line := range zone.lines {
+		if line.kind == "record" && line.record.Type == recordType && dnsFQDN(line.record.Name, domain) == dnsFQDN(name, domain) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	zone.lines = lines
+	zone.bumpSerial()
+}
+
+// RemoveDNSRecord removes the records of a zone with the given name, type and data, as
+// if it was done outside Terraform.
+func (s *Server) RemoveDNSRecord(domain, name, recordType string, data ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.dnsZones[domain]
+	lines := []dnsLine{}
+	for _, line := range zone.lines {
+		if line.kind == "record" && line.record.Type == recordType && dnsFQDN(line.record.Name, domain) == dnsFQDN(name, domain) && strings.Join(line.record.Data, " ") == strings.Join(data, " ") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	zone.lines = lines
+	zone.bumpSerial()
+}
+
+// SetDNSRecordData changes the data of the records of a zone with the given name and
+// type, as if it was done outside Terraform.
+func (s *Server) SetDNSRecordData(domain, name, recordType string, data ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone := s.dnsZones[domain]
+	for i, line := range zone.lines {
+		if line.kind == "record" && line.record.Type == recordType && dnsFQDN(line.record.Name, domain) == dnsFQDN(name, domain) {
+			zone.lines[i].record.Data = data
+		}
+	}
+	zone.bumpSerial()
+}
+
+func (s *Server) registerDNS() {
+	s.register(apiUAPI, cpanel.ModuleDNS, "parse_zone", "GET", s.dnsParseZone)
+	s.register(apiUAPI, cpanel.ModuleDNS, "mass_edit_zone", "POST", s.dnsMassEditZone)
+}
+
+func (s *Server) dnsParseZone(params url.Values) (interface{}, error) {
+	zone, ok := s.dnsZones[params.Get("zone")]
+	if !ok {
+		return nil, errorf("The zone “%s” does not exist.", params.Get("zone"))
+	}
+
+	data := []interface{}{}
+	for i, line := range zone.lines {
+		if line.kind != "record" {
+			data = append(data, map[string]interface{}{
+				"line_index": i,
+				"type":       line.kind,
+				"text_b64":   base64.StdEncoding.EncodeToString([]byte(line.text)),
+			})
+			continue
+		}
+
+		dataB64 := []string{}
+		for _, value := range line.record.Data {
+			dataB64 = append(dataB64, base64.StdEncoding.EncodeToString([]byte(value)))
+		}
+
+		data = append(data, map[string]interface{}{
+			"line_index":  i,
+			"type":        line.kind,
+			"record_type": line.record.Type,
+			"dname_b64":   base64.StdEncoding.EncodeToString([]byte(line.record.Name)),
+			"ttl":         line.record.TTL,
+			"data_b64":    dataB64,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) dnsMassEditZone(params url.Values) (interface{}, error) {
+	domain := params.Get("zone")
+	zone, ok := s.dnsZones[domain]
+	if !ok {
+		return nil, errorf("The zone “%s” does not exist.", domain)
+	}
+
+	if serial := params.Get("serial"); serial != strconv.FormatInt(zone.serial, 10) {
+		return nil, errorf("The given serial number (%s) does not match the DNS zone’s serial number (%d). Refresh your view of the DNS zone, then resubmit.", serial, zone.serial)
+	}
+
+	lines := append([]dnsLine{}, zone.lines...)
+
+	for _, value := range dnsMultiValues(params, "edit") {
+		var edit struct {
+			LineIndex  int      `json:"line_index"`
+			Dname      string   `json:"dname"`
+			TTL        int64    `json:"ttl"`
+			RecordType string   `json:"record_type"`
+			Data       []string `json:"data"`
+		}
+		if err := json.Unmarshal([]byte(value), &edit); err != nil {
+			return nil, errorf("The edit “%s” is invalid: %s", value, err)
+		}
+		if edit.LineIndex < 0 || edit.LineIndex >= len(lines) || lines[edit.LineIndex].kind != "record" {
+			return nil, errorf("The zone has no record on line %d.", edit.LineIndex)
+		}
+		lines[edit.LineIndex].record = DNSRecord{Name: edit.Dname, TTL: edit.TTL, Type: edit.RecordType, Data: edit.Data}
+	}
+
+	removed := map[int]bool{}
+	for _, value := range dnsMultiValues(params, "remove") {
+		lineIndex, err := strconv.Atoi(value)
+		if err != nil || lineIndex < 0 || lineIndex >= len(lines) || lines[lineIndex].kind != "record" {
+			return nil, errorf("The zone has no record on line %s.", value)
+		}
+		removed[lineIndex] = true
+	}
+
+	zone.lines = []dnsLine{}
+	for i, line := range lines {
+		if !removed[i] {
+			zone.lines = append(zone.lines, line)
+		}
+	}
+
+	for _, value := range dnsMultiValues(params, "add") {
+		var add struct {
+			Dname      string   `json:"dname"`
+			TTL        int64    `json:"ttl"`
+			RecordType string   `json:"record_type"`
+			Data       []string `json:"data"`
+		}
+		if err := json.Unmarshal([]byte(value), &add); err != nil {
+			return nil, errorf("The addition “%s” is invalid: %s", value, err)
+		}
+		zone.lines = append(zone.lines, dnsLine{kind: "record", record: DNSRecord{Name: add.Dname, TTL: add.TTL, Type: add.RecordType, Data: add.Data}})
+	}
+
+	zone.bumpSerial()
+
+	return map[string]interface{}{"new_serial": strconv.FormatInt(zone.serial, 10)}, nil
+}
+
+// bumpSerial increments the serial of the zone, in its SOA record too.
+func (z *dnsZone) bumpSerial() {
+	z.serial++
+
+	for i, line := range z.lines {
+		if line.kind == "record" && line.record.Type == "SOA" && len(line.record.Data) > 2 {
+			data := append([]string{}, line.record.Data...)
+			data[2] = strconv.FormatInt(z.serial, 10)
+			z.lines[i].record.Data = data
+		}
+	}
+}
+
+// dnsMultiValues returns the values of a parameter accepting several values: name,
+// name-1, name-2...
+func dnsMultiValues(params url.Values, name string) []string {
+	keys := []string{}
+	for key := range params {
+		if key == name || strings.HasPrefix(key, name+"-") {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return dnsParamIndex(keys[i]) < dnsParamIndex(keys[j])
+	})
+
+	values := []string{}
+	for _, key := range keys {
+		values = append(values, params[key]...)
+	}
+
+	return values
+}
+
+func dnsParamIndex(key string) int {
+	_, suffix, found := strings.Cut(key, "-")
+	if !found {
+		return 0
+	}
+
+	i, _ := strconv.Atoi(suffix)
+
+	return i
+}
+
+func dnsFQDN(name, domain string) string {
+	switch {
+	case name == "@":
+		return domain + "."
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(fmt.Sprintf("%s.%s.", name, domain))
+	}
+}
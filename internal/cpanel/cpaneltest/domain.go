@@ -0,0 +1,329 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+const (
+	// MainDomain is the main domain of the fake account.
+	MainDomain = "example.com"
+	// HomeDir is the home directory of the fake account.
+	HomeDir = "/home/" + Username
+	// PHPVersion is the PHP version of every domain of the fake account.
+	PHPVersion = "ea-php81"
+)
+
+// Subdomain is a subdomain of the fake server, Dir being relative to the home directory.
+type Subdomain struct {
+	Subdomain  string
+	RootDomain string
+	Dir        string
+}
+
+// AddonDomain is an addon domain of the fake server, backed by a subdomain of the main domain.
+type AddonDomain struct {
+	Domain    string
+	Subdomain string
+	Dir       string
+}
+
+// AddSubdomain creates or replaces a subdomain.
+func (s *Server) AddSubdomain(subdomain, rootDomain, dir string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.subdomains[subdomain+"."+rootDomain] = &Subdomain{Subdomain: subdomain, RootDomain: rootDomain, Dir: dir}
+}
+
+// DeleteSubdomain deletes a subdomain from its fully qualified name, as if it was done outside Terraform.
+func (s *Server) DeleteSubdomain(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subdomains, domain)
+}
+
+// Subdomain returns a subdomain from its fully qualified name, or nil if it does not exist.
+func (s *Server) Subdomain(domain string) *Subdomain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subdomain, ok := s.subdomains[domain]
+	if !ok {
+		return nil
+	}
+
+	subdomainCopy := *subdomain
+
+	return &subdomainCopy
+}
+
+// AddAddonDomain creates or replaces an addon domain.
+func (s *Server) AddAddonDomain(domain, subdomain, dir string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addonDomains[domain] = &AddonDomain{Domain: domain, Subdomain: subdomain, Dir: dir}
+}
+
+// DeleteAddonDomain deletes an addon domain, as if it was done outside Terraform.
+func (s *Server) DeleteAddonDomain(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.addonDomains, domain)
+}
+
+// AddonDomain returns an addon domain, or nil if it does not exist.
+func (s *Server) AddonDomain(domain string) *AddonDomain {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	addonDomain, ok := s.addonDomains[domain]
+	if !ok {
+		return nil
+	}
+
+	addonDomainCopy := *addonDomain
+
+	return &addonDomainCopy
+}
+
+// AddParkedDomain parks a domain on the main domain.
+func (s *Server) AddParkedDomain(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.parkedDomains[domain] = true
+}
+
+// DeleteParkedDomain unparks a domain, as if it was done outside Terraform.
+func (s *Server) DeleteParkedDomain(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.parkedDomains, domain)
+}
+
+// ParkedDomainExists reports whether a domain is parked on the main domain.
+func (s *Server) ParkedDomainExists(domain string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.parkedDomains[domain]
+}
+
+func (s *Server) registerDomains() {
+	s.register(apiUAPI, cpanel.ModuleSubDomain, "addsubdomain", "POST", s.subDomainAddSubdomain)
+	s.register(apiAPI2, cpanel.ModuleSubDomain, "listsubdomains", "GET", s.subDomainListSubdomains)
+	s.register(apiAPI2, cpanel.ModuleSubDomain, "changedocroot", "POST", s.subDomainChangeDocroot)
+	s.register(apiAPI2, cpanel.ModuleSubDomain, "delsubdomain", "POST", s.subDomainDelSubdomain)
+	s.register(apiAPI2, cpanel.ModuleAddonDomain, "addaddondomain", "POST", s.addonDomainAdd)
+	s.register(apiAPI2, cpanel.ModuleAddonDomain, "listaddondomains", "GET", s.addonDomainList)
+	s.register(apiAPI2, cpanel.ModuleAddonDomain, "deladdondomain", "POST", s.addonDomainDelete)
+	s.register(apiAPI2, cpanel.ModulePark, "park", "POST", s.parkPark)
+	s.register(apiAPI2, cpanel.ModulePark, "listparkeddomains", "GET", s.parkList)
+	s.register(apiAPI2, cpanel.ModulePark, "unpark", "POST", s.parkUnpark)
+	s.register(apiUAPI, cpanel.ModuleDomainInfo, "domains_data", "GET", s.domainInfoDomainsData)
+}
+
+// domainExists reports whether the domain is the main domain, an addon domain or a parked domain.
+func (s *Server) domainExists(domain string) bool {
+	_, addon := s.addonDomains[domain]
+
+	return domain == MainDomain || addon || s.parkedDomains[domain]
+}
+
+func (s *Server) subDomainAddSubdomain(params url.Values) (interface{}, error) {
+	subdomain, rootDomain := params.Get("domain"), params.Get("rootdomain")
+	if !s.domainExists(rootDomain) {
+		return nil, errorf("The domain “%s” does not exist.", rootDomain)
+	}
+
+	domain := subdomain + "." + rootDomain
+	if _, ok := s.subdomains[domain]; ok {
+		return nil, errorf("The subdomain “%s” already exists.", domain)
+	}
+
+	dir := relativeDir(params.Get("dir"))
+	if dir == "" {
+		dir = "public_html/" + subdomain
+	}
+
+	s.subdomains[domain] = &Subdomain{Subdomain: subdomain, RootDomain: rootDomain, Dir: dir}
+
+	return nil, nil
+}
+
+func (s *Server) subDomainListSubdomains(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, domain := range sortedKeys(s.subdomains) {
+		subdomain := s.subdomains[domain]
+		data = append(data, map[string]interface{}{
+			"domain":     domain,
+			"subdomain":  subdomain.Subdomain,
+			"rootdomain": subdomain.RootDomain,
+			"dir":        HomeDir + "/" + subdomain.Dir,
+			"basedir":    subdomain.Dir,
+			"reldir":     "home:" + subdomain.Dir,
+			"status":     "not redirected",
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) subDomainChangeDocroot(params url.Values) (interface{}, error) {
+	subdomain, rootDomain, dir := params.Get("subdomain"), params.Get("rootdomain"), relativeDir(params.Get("dir"))
+
+	if existing, ok := s.subdomains[subdomain+"."+rootDomain]; ok {
+		existing.Dir = dir
+		return []interface{}{map[string]interface{}{"result": 1, "reason": "The document root was changed."}}, nil
+	}
+
+	// Addon domains are backed by a subdomain of the main domain
+	for _, addonDomain := range s.addonDomains {
+		if addonDomain.Subdomain == subdomain && rootDomain == MainDomain {
+			addonDomain.Dir = dir
+			return []interface{}{map[string]interface{}{"result": 1, "reason": "The document root was changed."}}, nil
+		}
+	}
+
+	return nil, errorf("The subdomain “%s.%s” does not exist.", subdomain, rootDomain)
+}
+
+func (s *Server) subDomainDelSubdomain(params url.Values) (interface{}, error) {
+	domain := params.Get("domain")
+	if _, ok := s.subdomains[domain]; !ok {
+		return nil, errorf("The subdomain “%s” does not exist.", domain)
+	}
+
+	delete(s.subdomains, domain)
+
+	return []interface{}{map[string]interface{}{"result": 1, "reason": "The subdomain was removed."}}, nil
+}
+
+func (s *Server) addonDomainAdd(params url.Values) (interface{}, error) {
+	domain := params.Get("newdomain")
+	if s.domainExists(domain) {
+		return nil, errorf("The domain “%s” already exists.", domain)
+	}
+
+	s.addonDomains[domain] = &AddonDomain{Domain: domain, Subdomain: params.Get("subdomain"), Dir: relativeDir(params.Get("dir"))}
+
+	return []interface{}{map[string]interface{}{"result": 1, "reason": "The addon domain was created."}}, nil
+}
+
+func (s *Server) addonDomainList(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, domain := range sortedKeys(s.addonDomains) {
+		addonDomain := s.addonDomains[domain]
+		data = append(data, map[string]interface{}{
+			"domain":        domain,
+			"subdomain":     addonDomain.Subdomain,
+			"rootdomain":    MainDomain,
+			"fullsubdomain": addonDomain.Subdomain + "." + MainDomain,
+			"dir":           HomeDir + "/" + addonDomain.Dir,
+			"basedir":       addonDomain.Dir,
+			"reldir":        "home:" + addonDomain.Dir,
+			"status":        "not redirected",
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) addonDomainDelete(params url.Values) (interface{}, error) {
+	domain := params.Get("domain")
+	addonDomain, ok := s.addonDomains[domain]
+	if !ok {
+		return nil, errorf("The addon domain “%s” does not exist.", domain)
+	}
+
+	if subdomain := params.Get("subdomain"); subdomain != addonDomain.Subdomain+"_"+MainDomain {
+		return []interface{}{map[string]interface{}{"result": 0, "reason": "The subdomain “" + subdomain + "” does not match the addon domain."}}, nil
+	}
+
+	delete(s.addonDomains, domain)
+
+	return []interface{}{map[string]interface{}{"result": 1, "reason": "The addon domain was removed."}}, nil
+}
+
+func (s *Server) parkPark(params url.Values) (interface{}, error) {
+	domain := params.Get("domain")
+	if s.domainExists(domain) {
+		return []interface{}{map[string]interface{}{"result": 0, "reason": "The domain “" + domain + "” already exists."}}, nil
+	}
+
+	s.parkedDomains[domain] = true
+
+	return []interface{}{map[string]interface{}{"result": 1, "reason": "The domain was parked."}}, nil
+}
+
+func (s *Server) parkList(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, domain := range sortedKeys(s.parkedDomains) {
+		data = append(data, map[string]interface{}{
+			"domain":  domain,
+			"dir":     HomeDir + "/public_html",
+			"basedir": "public_html",
+			"reldir":  "home:public_html",
+			"status":  "not redirected",
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) parkUnpark(params url.Values) (interface{}, error) {
+	domain := params.Get("domain")
+	if !s.parkedDomains[domain] {
+		return nil, errorf("The parked domain “%s” does not exist.", domain)
+	}
+
+	delete(s.parkedDomains, domain)
+
+	return []interface{}{map[string]interface{}{"result": 1, "reason": "The domain was unparked."}}, nil
+}
+
+func (s *Server) domainInfoDomainsData(_ url.Values) (interface{}, error) {
+	addonDomains := []interface{}{}
+	for _, domain := range sortedKeys(s.addonDomains) {
+		addonDomains = append(addonDomains, domainData(domain, "addon_domain", s.addonDomains[domain].Dir))
+	}
+
+	subDomains := []interface{}{}
+	for _, domain := range sortedKeys(s.subdomains) {
+		subDomains = append(subDomains, domainData(domain, "sub_domain", s.subdomains[domain].Dir))
+	}
+
+	return map[string]interface{}{
+		"main_domain":    domainData(MainDomain, "main_domain", "public_html"),
+		"addon_domains":  addonDomains,
+		"parked_domains": sortedKeys(s.parkedDomains),
+		"sub_domains":    subDomains,
+	}, nil
+}
+
+func domainData(domain, domainType, dir string) map[string]interface{} {
+	return map[string]interface{}{
+		"domain":       domain,
+		"type":         domainType,
+		"documentroot": HomeDir + "/" + dir,
+		"homedir":      HomeDir,
+		"phpversion":   PHPVersion,
+		"servername":   domain,
+		"serveralias":  "www." + domain,
+		"user":         Username,
+	}
+}
+
+// relativeDir returns a document root relative to the home directory.
+func relativeDir(dir string) string {
+	return strings.Trim(strings.TrimPrefix(dir, HomeDir), "/")
+}
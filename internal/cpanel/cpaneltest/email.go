@@ -0,0 +1,194 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// EmailAccount is an email account of the fake server.
+type EmailAccount struct {
+	User     string
+	Domain   string
+	Password string
+	QuotaMB  int64
+}
+
+// AddEmailAccount creates an email account, with a quota in megabytes (0 for unlimited).
+func (s *Server) AddEmailAccount(user, domain, password string, quotaMB int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.emailAccounts[user+"@"+domain] = &EmailAccount{User: user, Domain: domain, Password: password, QuotaMB: quotaMB}
+}
+
+// DeleteEmailAccount deletes an email account, as if it was done outside Terraform.
+func (s *Server) DeleteEmailAccount(address string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.emailAccounts, address)
+}
+
+// EmailAccount returns an email account from its address, or nil if it does not exist.
+func (s *Server) EmailAccount(address string) *EmailAccount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, ok := s.emailAccounts[address]
+	if !ok {
+		return nil
+	}
+
+	accountCopy := *account
+
+	return &accountCopy
+}
+
+func (s *Server) registerEmail() {
+	s.register(apiUAPI, cpanel.ModuleEmail, "list_pops_with_disk", "GET", s.emailListPopsWithDisk)
+	s.register(apiUAPI, cpanel.ModuleEmail, "add_pop", "POST", s.emailAddPop)
+	s.register(apiUAPI, cpanel.ModuleEmail, "passwd_pop", "POST", s.emailPasswdPop)
+	s.register(apiUAPI, cpanel.ModuleEmail, "edit_pop_quota", "POST", s.emailEditPopQuota)
+	s.register(apiUAPI, cpanel.ModuleEmail, "delete_pop", "POST", s.emailDeletePop)
+}
+
+func (s *Server) emailListPopsWithDisk(params url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, address := range sortedKeys(s.emailAccounts) {
+		account := s.emailAccounts[address]
+		if domain := params.Get("domain"); domain != "" && account.Domain != domain {
+			continue
+		}
+
+		// cPanel returns the quota as a string, and "unlimited" when there is none
+		diskQuota := "unlimited"
+		if account.QuotaMB > 0 {
+			diskQuota = strconv.FormatInt(account.QuotaMB, 10)
+		}
+
+		data = append(data, map[string]interface{}{
+			"email":      address,
+			"login":      address,
+			"user":       account.User,
+			"domain":     account.Domain,
+			"diskquota":  diskQuota,
+			"_diskquota": account.QuotaMB * 1024 * 1024,
+			"diskused":   "0",
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) emailAddPop(params url.Values) (interface{}, error) {
+	address, err := emailAddress(params)
+	if err != nil {
+		return nil, err
+	}
+	if params.Get("password") == "" {
+		return nil, errorf("The parameter “password” is required.")
+	}
+	if _, ok := s.emailAccounts[address]; ok {
+		return nil, errorf("The account %s already exists!", address)
+	}
+
+	quota, err := quotaParam(params.Get("quota"))
+	if err != nil {
+		return nil, err
+	}
+
+	user, domain, _ := strings.Cut(address, "@")
+	s.emailAccounts[address] = &EmailAccount{User: user, Domain: domain, Password: params.Get("password"), QuotaMB: quota}
+
+	return user + "+" + domain, nil
+}
+
+func (s *Server) emailPasswdPop(params url.Values) (interface{}, error) {
+	account, err := s.emailAccount(params)
+	if err != nil {
+		return nil, err
+	}
+
+	account.Password = params.Get("password")
+
+	return nil, nil
+}
+
+func (s *Server) emailEditPopQuota(params url.Values) (interface{}, error) {
+	account, err := s.emailAccount(params)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := quotaParam(params.Get("quota"))
+	if err != nil {
+		return nil, err
+	}
+
+	account.QuotaMB = quota
+
+	return nil, nil
+}
+
+func (s *Server) emailDeletePop(params url.Values) (interface{}, error) {
+	address, err := emailAddress(params)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.emailAccounts[address]; !ok {
+		return nil, errorf("The email account “%s” does not exist.", address)
+	}
+
+	delete(s.emailAccounts, address)
+
+	return nil, nil
+}
+
+func (s *Server) emailAccount(params url.Values) (*EmailAccount, error) {
+	address, err := emailAddress(params)
+	if err != nil {
+		return nil, err
+	}
+
+	account, ok := s.emailAccounts[address]
+	if !ok {
+		return nil, errorf("The email account “%s” does not exist.", address)
+	}
+
+	return account, nil
+}
+
+// emailAddress returns the address from the email parameter, either a full
+// address or a local part completed by the domain parameter.
+func emailAddress(params url.Values) (string, error) {
+	address := params.Get("email")
+	if address == "" {
+		return "", errorf("The parameter “email” is required.")
+	}
+
+	if !strings.Contains(address, "@") {
+		if params.Get("domain") == "" {
+			return "", errorf("The parameter “domain” is required.")
+		}
+		address += "@" + params.Get("domain")
+	}
+
+	return address, nil
+}
+
+// quotaParam parses a quota in megabytes, an empty value or "unlimited" meaning no quota.
+func quotaParam(value string) (int64, error) {
+	if value == "" || value == "unlimited" {
+		return 0, nil
+	}
+
+	quota, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || quota < 0 {
+		return 0, errorf("The quota “%s” is not valid.", value)
+	}
+
+	return quota, nil
+}
@@ -0,0 +1,171 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// EmailForwarder is a forwarder of the fake server, Forward being as listed by
+// list_forwarders: an address, |program, :fail: message or :blackhole:.
+type EmailForwarder struct {
+	Address string
+	Forward string
+}
+
+// AddEmailForwarder creates a forwarder, as if it was done outside Terraform.
+func (s *Server) AddEmailForwarder(address, forward string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.emailForwarders = append(s.emailForwarders, EmailForwarder{Address: address, Forward: forward})
+}
+
+// DeleteEmailForwarder deletes a forwarder, as if it was done outside Terraform.
+func (s *Server) DeleteEmailForwarder(address, forward string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.emailForwarders = slices.DeleteFunc(s.emailForwarders, func(f EmailForwarder) bool {
+		return f.Address == address && f.Forward == forward
+	})
+}
+
+// EmailForwarders returns the forwarders.
+func (s *Server) EmailForwarders() []EmailForwarder {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]EmailForwarder(nil), s.emailForwarders...)
+}
+
+// AddEmailDomainForwarder creates a domain forwarder, as if it was done outside Terraform.
+func (s *Server) AddEmailDomainForwarder(domain, destinationDomain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.emailDomainForwarders[domain] = destinationDomain
+}
+
+// EmailDomainForwarder returns the destination of a domain forwarder, and whether it exists.
+func (s *Server) EmailDomainForwarder(domain string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	destinationDomain, ok := s.emailDomainForwarders[domain]
+
+	return destinationDomain, ok
+}
+
+func (s *Server) registerEmailForwarders() {
+	s.register(apiUAPI, cpanel.ModuleEmail, "list_forwarders", "GET", s.emailListForwarders)
+	s.register(apiUAPI, cpanel.ModuleEmail, "add_forwarder", "POST", s.emailAddForwarder)
+	s.register(apiUAPI, cpanel.ModuleEmail, "delete_forwarder", "POST", s.emailDeleteForwarder)
+	s.register(apiUAPI, cpanel.ModuleEmail, "list_domain_forwarders", "GET", s.emailListDomainForwarders)
+	s.register(apiUAPI, cpanel.ModuleEmail, "add_domain_forwarder", "POST", s.emailAddDomainForwarder)
+	s.register(apiUAPI, cpanel.ModuleEmail, "delete_domain_forwarder", "POST", s.emailDeleteDomainForwarder)
+}
+
+func (s *Server) emailListForwarders(params url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, forwarder := range s.emailForwarders {
+		if domain := params.Get("domain"); domain != "" && !strings.HasSuffix(forwarder.Address, "@"+domain) {
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			"dest":    forwarder.Address,
+			"forward": forwarder.Forward,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) emailAddForwarder(params url.Values) (interface{}, error) {
+	address, err := emailAddress(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var forward string
+	switch params.Get("fwdopt") {
+	case "fwd":
+		forward = params.Get("fwdemail")
+	case "pipe":
+		// cPanel lists programs with their absolute path
+		forward = params.Get("pipefwd")
+		if forward != "" && !strings.HasPrefix(forward, "/") {
+			forward = "/home/" + Username + "/" + forward
+		}
+		if forward != "" {
+			forward = "|" + forward
+		}
+	case "fail":
+		forward = strings.TrimSpace(":fail: " + params.Get("failmsgs"))
+	case "blackhole":
+		forward = ":blackhole:"
+	default:
+		return nil, errorf("The parameter “fwdopt” is not valid.")
+	}
+	if forward == "" {
+		return nil, errorf("The forwarder destination is required.")
+	}
+
+	forwarder := EmailForwarder{Address: address, Forward: forward}
+	if !slices.Contains(s.emailForwarders, forwarder) {
+		s.emailForwarders = append(s.emailForwarders, forwarder)
+	}
+
+	return []interface{}{map[string]interface{}{"email": address, "forward": forward}}, nil
+}
+
+func (s *Server) emailDeleteForwarder(params url.Values) (interface{}, error) {
+	forwarder := EmailForwarder{Address: params.Get("address"), Forward: params.Get("forwarder")}
+
+	index := slices.Index(s.emailForwarders, forwarder)
+	if index < 0 {
+		return nil, errorf("The forwarder “%s” to “%s” does not exist.", forwarder.Address, forwarder.Forward)
+	}
+
+	s.emailForwarders = slices.Delete(s.emailForwarders, index, index+1)
+
+	return nil, nil
+}
+
+func (s *Server) emailListDomainForwarders(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, domain := range sortedKeys(s.emailDomainForwarders) {
+		data = append(data, map[string]interface{}{
+			"dest":    domain,
+			"forward": s.emailDomainForwarders[domain],
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) emailAddDomainForwarder(params url.Values) (interface{}, error) {
+	domain, destinationDomain := params.Get("domain"), params.Get("destdomain")
+	if domain == "" || destinationDomain == "" {
+		return nil, errorf("The parameters “domain” and “destdomain” are required.")
+	}
+
+	s.emailDomainForwarders[domain] = destinationDomain
+
+	return []interface{}{map[string]interface{}{"dest": domain, "forward": destinationDomain}}, nil
+}
+
+func (s *Server) emailDeleteDomainForwarder(params url.Values) (interface{}, error) {
+	domain := params.Get("domain")
+	if _, ok := s.emailDomainForwarders[domain]; !ok {
+		return nil, errorf("The domain forwarder “%s” does not exist.", domain)
+	}
+
+	delete(s.emailDomainForwarders, domain)
+
+	return nil, nil
+}
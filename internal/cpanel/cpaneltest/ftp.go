@@ -0,0 +1,176 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"strconv"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// FTPAccount is an FTP account of the fake server, HomeDir being relative to the home directory.
+type FTPAccount struct {
+	User     string
+	Domain   string
+	Password string
+	HomeDir  string
+	QuotaMB  int64
+}
+
+// AddFTPAccount creates or replaces an FTP account, with a quota in megabytes (0 for unlimited).
+func (s *Server) AddFTPAccount(user, domain, password, homeDir string, quotaMB int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ftpAccounts[user+"@"+domain] = &FTPAccount{User: user, Domain: domain, Password: password, HomeDir: homeDir, QuotaMB: quotaMB}
+}
+
+// DeleteFTPAccount deletes an FTP account from its login, as if it was done outside Terraform.
+func (s *Server) DeleteFTPAccount(login string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.ftpAccounts, login)
+}
+
+// FTPAccount returns an FTP account from its user@domain login, or nil if it does not exist.
+func (s *Server) FTPAccount(login string) *FTPAccount {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account, ok := s.ftpAccounts[login]
+	if !ok {
+		return nil
+	}
+
+	accountCopy := *account
+
+	return &accountCopy
+}
+
+func (s *Server) registerFTP() {
+	s.register(apiUAPI, cpanel.ModuleFtp, "list_ftp_with_disk", "GET", s.ftpListFtpWithDisk)
+	s.register(apiUAPI, cpanel.ModuleFtp, "add_ftp", "POST", s.ftpAddFtp)
+	s.register(apiUAPI, cpanel.ModuleFtp, "passwd", "POST", s.ftpPasswd)
+	s.register(apiUAPI, cpanel.ModuleFtp, "set_quota", "POST", s.ftpSetQuota)
+	s.register(apiUAPI, cpanel.ModuleFtp, "delete_ftp", "POST", s.ftpDeleteFtp)
+}
+
+func (s *Server) ftpListFtpWithDisk(_ url.Values) (interface{}, error) {
+	// The main account is listed along with the FTP accounts
+	data := []interface{}{
+		map[string]interface{}{
+			"login":       Username,
+			"serverlogin": Username,
+			"user":        Username,
+			"accttype":    "main",
+			"dir":         HomeDir,
+			"reldir":      "",
+			"diskquota":   "unlimited",
+			"diskused":    "0",
+			"deleteable":  0,
+		},
+	}
+
+	for _, login := range sortedKeys(s.ftpAccounts) {
+		account := s.ftpAccounts[login]
+
+		// cPanel returns the quota as a string, and "unlimited" when there is none
+		diskQuota := "unlimited"
+		if account.QuotaMB > 0 {
+			diskQuota = strconv.FormatInt(account.QuotaMB, 10)
+		}
+
+		data = append(data, map[string]interface{}{
+			"login":       login,
+			"serverlogin": login,
+			"user":        account.User,
+			"accttype":    "sub",
+			"dir":         HomeDir + "/" + account.HomeDir,
+			"reldir":      account.HomeDir,
+			"diskquota":   diskQuota,
+			"diskused":    "0",
+			"deleteable":  1,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) ftpAddFtp(params url.Values) (interface{}, error) {
+	user, domain := params.Get("user"), params.Get("domain")
+	if user == "" {
+		return nil, errorf("The parameter “user” is required.")
+	}
+	if params.Get("pass") == "" {
+		return nil, errorf("The parameter “pass” is required.")
+	}
+	if _, subdomain := s.subdomains[domain]; !s.domainExists(domain) && !subdomain {
+		return nil, errorf("The domain “%s” does not exist.", domain)
+	}
+
+	login := user + "@" + domain
+	if _, ok := s.ftpAccounts[login]; ok {
+		return nil, errorf("The FTP account “%s” already exists.", login)
+	}
+
+	quota, err := quotaParam(params.Get("quota"))
+	if err != nil {
+		return nil, err
+	}
+
+	homeDir := relativeDir(params.Get("homedir"))
+	if homeDir == "" {
+		homeDir = "public_html/" + user
+	}
+
+	s.ftpAccounts[login] = &FTPAccount{User: user, Domain: domain, Password: params.Get("pass"), HomeDir: homeDir, QuotaMB: quota}
+
+	return nil, nil
+}
+
+func (s *Server) ftpPasswd(params url.Values) (interface{}, error) {
+	account, err := s.ftpAccount(params)
+	if err != nil {
+		return nil, err
+	}
+
+	account.Password = params.Get("pass")
+
+	return nil, nil
+}
+
+func (s *Server) ftpSetQuota(params url.Values) (interface{}, error) {
+	account, err := s.ftpAccount(params)
+	if err != nil {
+		return nil, err
+	}
+
+	quota, err := quotaParam(params.Get("quota"))
+	if err != nil {
+		return nil, err
+	}
+
+	account.QuotaMB = quota
+
+	return nil, nil
+}
+
+func (s *Server) ftpDeleteFtp(params url.Values) (interface{}, error) {
+	if _, err := s.ftpAccount(params); err != nil {
+		return nil, err
+	}
+
+	delete(s.ftpAccounts, params.Get("user")+"@"+params.Get("domain"))
+
+	return nil, nil
+}
+
+func (s *Server) ftpAccount(params url.Values) (*FTPAccount, error) {
+	login := params.Get("user") + "@" + params.Get("domain")
+
+	account, ok := s.ftpAccounts[login]
+	if !ok {
+		return nil, errorf("The FTP account “%s” does not exist.", login)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,320 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+)
+
+// MySQLDatabase is a database of the fake server, along with the privileges of each user granted on it.
+type MySQLDatabase struct {
+	Name       string
+	Privileges map[string][]string
+}
+
+// AddMySQLDatabase creates a database granting all privileges to the given users.
+func (s *Server) AddMySQLDatabase(name string, users ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	database := &MySQLDatabase{Name: name, Privileges: map[string][]string{}}
+	for _, user := range users {
+		database.Privileges[user] = []string{mysql.AllPrivileges}
+	}
+
+	s.mySQLDatabases[name] = database
+}
+
+// SetMySQLPrivileges replaces the privileges of a user on a database, as if it was done outside Terraform.
+// No privileges revokes the access of the user to the database.
+func (s *Server) SetMySQLPrivileges(database, user string, privileges ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(privileges) == 0 {
+		delete(s.mySQLDatabases[database].Privileges, user)
+		return
+	}
+
+	s.mySQLDatabases[database].Privileges[user] = privileges
+}
+
+// DeleteMySQLDatabase deletes a database, as if it was done outside Terraform.
+func (s *Server) DeleteMySQLDatabase(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.mySQLDatabases, name)
+}
+
+// MySQLDatabase returns a database, or nil if it does not exist.
+func (s *Server) MySQLDatabase(name string) *MySQLDatabase {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	database, ok := s.mySQLDatabases[name]
+	if !ok {
+		return nil
+	}
+
+	privileges := map[string][]string{}
+	for user, userPrivileges := range database.Privileges {
+		privileges[user] = append([]string(nil), userPrivileges...)
+	}
+
+	return &MySQLDatabase{Name: database.Name, Privileges: privileges}
+}
+
+// AddMySQLUser creates a user.
+func (s *Server) AddMySQLUser(name, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mySQLUsers[name] = password
+}
+
+// DeleteMySQLUser deletes a user, as if it was done outside Terraform.
+func (s *Server) DeleteMySQLUser(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mySQLDeleteUserLocked(name)
+}
+
+// MySQLUserPassword returns the password of a user, and whether the user exists.
+func (s *Server) MySQLUserPassword(name string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	password, ok := s.mySQLUsers[name]
+
+	return password, ok
+}
+
+func (s *Server) registerMySQL() {
+	s.register(apiUAPI, cpanel.ModuleMysql, "list_databases", "GET", s.mySQLListDatabases)
+	s.register(apiUAPI, cpanel.ModuleMysql, "create_database", "POST", s.mySQLCreateDatabase)
+	s.register(apiUAPI, cpanel.ModuleMysql, "rename_database", "POST", s.mySQLRenameDatabase)
+	s.register(apiUAPI, cpanel.ModuleMysql, "delete_database", "POST", s.mySQLDeleteDatabase)
+	s.register(apiUAPI, cpanel.ModuleMysql, "list_users", "GET", s.mySQLListUsers)
+	s.register(apiUAPI, cpanel.ModuleMysql, "create_user", "POST", s.mySQLCreateUser)
+	s.register(apiUAPI, cpanel.ModuleMysql, "rename_user", "POST", s.mySQLRenameUser)
+	s.register(apiUAPI, cpanel.ModuleMysql, "set_password", "POST", s.mySQLSetPassword)
+	s.register(apiUAPI, cpanel.ModuleMysql, "delete_user", "POST", s.mySQLDeleteUser)
+	s.register(apiUAPI, cpanel.ModuleMysql, "get_privileges_on_database", "GET", s.mySQLGetPrivilegesOnDatabase)
+	s.register(apiUAPI, cpanel.ModuleMysql, "set_privileges_on_database", "POST", s.mySQLSetPrivilegesOnDatabase)
+	s.register(apiUAPI, cpanel.ModuleMysql, "revoke_access_to_database", "POST", s.mySQLRevokeAccessToDatabase)
+}
+
+func (s *Server) mySQLListDatabases(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, name := range sortedKeys(s.mySQLDatabases) {
+		database := s.mySQLDatabases[name]
+		users := sortedKeys(database.Privileges)
+		data = append(data, map[string]interface{}{
+			"database":   database.Name,
+			"disk_usage": 0,
+			"users":      users,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) mySQLCreateDatabase(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if name == "" {
+		return nil, errorf("The parameter “name” is required.")
+	}
+	if _, ok := s.mySQLDatabases[name]; ok {
+		return nil, errorf("The database “%s” already exists.", name)
+	}
+
+	s.mySQLDatabases[name] = &MySQLDatabase{Name: name, Privileges: map[string][]string{}}
+
+	return nil, nil
+}
+
+func (s *Server) mySQLRenameDatabase(params url.Values) (interface{}, error) {
+	oldName, newName := params.Get("oldname"), params.Get("newname")
+
+	database, ok := s.mySQLDatabases[oldName]
+	if !ok {
+		return nil, errorf("The database “%s” does not exist.", oldName)
+	}
+	if _, ok := s.mySQLDatabases[newName]; ok {
+		return nil, errorf("The database “%s” already exists.", newName)
+	}
+
+	delete(s.mySQLDatabases, oldName)
+	database.Name = newName
+	s.mySQLDatabases[newName] = database
+
+	return nil, nil
+}
+
+func (s *Server) mySQLDeleteDatabase(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if _, ok := s.mySQLDatabases[name]; !ok {
+		return nil, errorf("The database “%s” does not exist.", name)
+	}
+
+	delete(s.mySQLDatabases, name)
+
+	return nil, nil
+}
+
+func (s *Server) mySQLListUsers(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, name := range sortedKeys(s.mySQLUsers) {
+		databases := []string{}
+		for _, database := range sortedKeys(s.mySQLDatabases) {
+			if _, ok := s.mySQLDatabases[database].Privileges[name]; ok {
+				databases = append(databases, database)
+			}
+		}
+
+		data = append(data, map[string]interface{}{
+			"user":      name,
+			"databases": databases,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) mySQLCreateUser(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if name == "" || params.Get("password") == "" {
+		return nil, errorf("The parameters “name” and “password” are required.")
+	}
+	if _, ok := s.mySQLUsers[name]; ok {
+		return nil, errorf("The user “%s” already exists.", name)
+	}
+
+	s.mySQLUsers[name] = params.Get("password")
+
+	return nil, nil
+}
+
+func (s *Server) mySQLRenameUser(params url.Values) (interface{}, error) {
+	oldName, newName := params.Get("oldname"), params.Get("newname")
+
+	password, ok := s.mySQLUsers[oldName]
+	if !ok {
+		return nil, errorf("The user “%s” does not exist.", oldName)
+	}
+	if _, ok := s.mySQLUsers[newName]; ok {
+		return nil, errorf("The user “%s” already exists.", newName)
+	}
+
+	// Unlike PostgreSQL, MySQL keeps the password of a renamed user
+	delete(s.mySQLUsers, oldName)
+	s.mySQLUsers[newName] = password
+
+	for _, database := range s.mySQLDatabases {
+		if privileges, ok := database.Privileges[oldName]; ok {
+			delete(database.Privileges, oldName)
+			database.Privileges[newName] = privileges
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Server) mySQLSetPassword(params url.Values) (interface{}, error) {
+	name := params.Get("user")
+	if _, ok := s.mySQLUsers[name]; !ok {
+		return nil, errorf("The user “%s” does not exist.", name)
+	}
+
+	s.mySQLUsers[name] = params.Get("password")
+
+	return nil, nil
+}
+
+func (s *Server) mySQLDeleteUser(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if _, ok := s.mySQLUsers[name]; !ok {
+		return nil, errorf("The user “%s” does not exist.", name)
+	}
+
+	s.mySQLDeleteUserLocked(name)
+
+	return nil, nil
+}
+
+func (s *Server) mySQLDeleteUserLocked(name string) {
+	delete(s.mySQLUsers, name)
+	for _, database := range s.mySQLDatabases {
+		delete(database.Privileges, name)
+	}
+}
+
+func (s *Server) mySQLGetPrivilegesOnDatabase(params url.Values) (interface{}, error) {
+	database, user, err := s.mySQLGrant(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]string{}, database.Privileges[user]...), nil
+}
+
+func (s *Server) mySQLSetPrivilegesOnDatabase(params url.Values) (interface{}, error) {
+	database, user, err := s.mySQLGrant(params)
+	if err != nil {
+		return nil, err
+	}
+
+	privileges := []string{}
+	for _, privilege := range strings.Split(params.Get("privileges"), ",") {
+		privilege = strings.ToUpper(strings.TrimSpace(privilege))
+		if privilege == "ALL" {
+			privilege = mysql.AllPrivileges
+		}
+		if privilege != mysql.AllPrivileges && !slices.Contains(mysql.Privileges, privilege) {
+			return nil, errorf("The privilege “%s” is not valid.", privilege)
+		}
+		if !slices.Contains(privileges, privilege) {
+			privileges = append(privileges, privilege)
+		}
+	}
+
+	// cPanel reports every privilege granted one by one as ALL PRIVILEGES
+	if len(privileges) == len(mysql.Privileges) {
+		privileges = []string{mysql.AllPrivileges}
+	}
+
+	database.Privileges[user] = privileges
+
+	return nil, nil
+}
+
+func (s *Server) mySQLRevokeAccessToDatabase(params url.Values) (interface{}, error) {
+	database, user, err := s.mySQLGrant(params)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(database.Privileges, user)
+
+	return nil, nil
+}
+
+func (s *Server) mySQLGrant(params url.Values) (*MySQLDatabase, string, error) {
+	database, ok := s.mySQLDatabases[params.Get("database")]
+	if !ok {
+		return nil, "", errorf("The database “%s” does not exist.", params.Get("database"))
+	}
+
+	user := params.Get("user")
+	if _, ok := s.mySQLUsers[user]; !ok {
+		return nil, "", errorf("The user “%s” does not exist.", user)
+	}
+
+	return database, user, nil
+}
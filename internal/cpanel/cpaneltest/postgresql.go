@@ -0,0 +1,260 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"slices"
+	"sort"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// PostgreSQLDatabase is a database of the fake server, along with the users granted on it.
+type PostgreSQLDatabase struct {
+	Name  string
+	Users []string
+}
+
+// AddPostgreSQLDatabase creates a database granted to the given users.
+func (s *Server) AddPostgreSQLDatabase(name string, users ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.postgreSQLDatabases[name] = &PostgreSQLDatabase{Name: name, Users: users}
+}
+
+// DeletePostgreSQLDatabase deletes a database, as if it was done outside Terraform.
+func (s *Server) DeletePostgreSQLDatabase(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.postgreSQLDatabases, name)
+}
+
+// PostgreSQLDatabase returns a database, or nil if it does not exist.
+func (s *Server) PostgreSQLDatabase(name string) *PostgreSQLDatabase {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	database, ok := s.postgreSQLDatabases[name]
+	if !ok {
+		return nil
+	}
+
+	return &PostgreSQLDatabase{Name: database.Name, Users: append([]string(nil), database.Users...)}
+}
+
+// AddPostgreSQLUser creates a user.
+func (s *Server) AddPostgreSQLUser(name, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.postgreSQLUsers[name] = password
+}
+
+// DeletePostgreSQLUser deletes a user, as if it was done outside Terraform.
+func (s *Server) DeletePostgreSQLUser(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.postgreSQLUsers, name)
+	for _, database := range s.postgreSQLDatabases {
+		database.Users = slices.DeleteFunc(database.Users, func(user string) bool { return user == name })
+	}
+}
+
+// PostgreSQLUserPassword returns the password of a user, and whether the user exists.
+func (s *Server) PostgreSQLUserPassword(name string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	password, ok := s.postgreSQLUsers[name]
+
+	return password, ok
+}
+
+func (s *Server) registerPostgreSQL() {
+	s.register(apiUAPI, cpanel.ModulePostgresql, "list_databases", "GET", s.postgreSQLListDatabases)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "create_database", "POST", s.postgreSQLCreateDatabase)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "rename_database", "POST", s.postgreSQLRenameDatabase)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "delete_database", "POST", s.postgreSQLDeleteDatabase)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "list_users", "GET", s.postgreSQLListUsers)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "create_user", "POST", s.postgreSQLCreateUser)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "rename_user", "POST", s.postgreSQLRenameUser)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "set_password", "POST", s.postgreSQLSetPassword)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "delete_user", "POST", s.postgreSQLDeleteUser)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "grant_all_privileges", "POST", s.postgreSQLGrantAllPrivileges)
+	s.register(apiUAPI, cpanel.ModulePostgresql, "revoke_all_privileges", "POST", s.postgreSQLRevokeAllPrivileges)
+}
+
+func (s *Server) postgreSQLListDatabases(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, name := range sortedKeys(s.postgreSQLDatabases) {
+		database := s.postgreSQLDatabases[name]
+		users := append([]string{}, database.Users...)
+		data = append(data, map[string]interface{}{
+			"database":   database.Name,
+			"disk_usage": 0,
+			"users":      users,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) postgreSQLCreateDatabase(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if name == "" {
+		return nil, errorf("The parameter “name” is required.")
+	}
+	if _, ok := s.postgreSQLDatabases[name]; ok {
+		return nil, errorf("The database “%s” already exists.", name)
+	}
+
+	s.postgreSQLDatabases[name] = &PostgreSQLDatabase{Name: name}
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLRenameDatabase(params url.Values) (interface{}, error) {
+	oldName, newName := params.Get("oldname"), params.Get("newname")
+
+	database, ok := s.postgreSQLDatabases[oldName]
+	if !ok {
+		return nil, errorf("The database “%s” does not exist.", oldName)
+	}
+	if _, ok := s.postgreSQLDatabases[newName]; ok {
+		return nil, errorf("The database “%s” already exists.", newName)
+	}
+
+	delete(s.postgreSQLDatabases, oldName)
+	database.Name = newName
+	s.postgreSQLDatabases[newName] = database
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLDeleteDatabase(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if _, ok := s.postgreSQLDatabases[name]; !ok {
+		return nil, errorf("The database “%s” does not exist.", name)
+	}
+
+	delete(s.postgreSQLDatabases, name)
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLListUsers(_ url.Values) (interface{}, error) {
+	return sortedKeys(s.postgreSQLUsers), nil
+}
+
+func (s *Server) postgreSQLCreateUser(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if name == "" || params.Get("password") == "" {
+		return nil, errorf("The parameters “name” and “password” are required.")
+	}
+	if _, ok := s.postgreSQLUsers[name]; ok {
+		return nil, errorf("The user “%s” already exists.", name)
+	}
+
+	s.postgreSQLUsers[name] = params.Get("password")
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLRenameUser(params url.Values) (interface{}, error) {
+	oldName, newName := params.Get("oldname"), params.Get("newname")
+
+	if _, ok := s.postgreSQLUsers[oldName]; !ok {
+		return nil, errorf("The user “%s” does not exist.", oldName)
+	}
+	if _, ok := s.postgreSQLUsers[newName]; ok {
+		return nil, errorf("The user “%s” already exists.", newName)
+	}
+
+	delete(s.postgreSQLUsers, oldName)
+	s.postgreSQLUsers[newName] = params.Get("password")
+
+	for _, database := range s.postgreSQLDatabases {
+		for i, user := range database.Users {
+			if user == oldName {
+				database.Users[i] = newName
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLSetPassword(params url.Values) (interface{}, error) {
+	name := params.Get("user")
+	if _, ok := s.postgreSQLUsers[name]; !ok {
+		return nil, errorf("The user “%s” does not exist.", name)
+	}
+
+	s.postgreSQLUsers[name] = params.Get("password")
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLDeleteUser(params url.Values) (interface{}, error) {
+	name := params.Get("name")
+	if _, ok := s.postgreSQLUsers[name]; !ok {
+		return nil, errorf("The user “%s” does not exist.", name)
+	}
+
+	delete(s.postgreSQLUsers, name)
+	for _, database := range s.postgreSQLDatabases {
+		database.Users = slices.DeleteFunc(database.Users, func(user string) bool { return user == name })
+	}
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLGrantAllPrivileges(params url.Values) (interface{}, error) {
+	database, user, err := s.postgreSQLGrant(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(database.Users, user) {
+		database.Users = append(database.Users, user)
+	}
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLRevokeAllPrivileges(params url.Values) (interface{}, error) {
+	database, user, err := s.postgreSQLGrant(params)
+	if err != nil {
+		return nil, err
+	}
+
+	database.Users = slices.DeleteFunc(database.Users, func(u string) bool { return u == user })
+
+	return nil, nil
+}
+
+func (s *Server) postgreSQLGrant(params url.Values) (*PostgreSQLDatabase, string, error) {
+	database, ok := s.postgreSQLDatabases[params.Get("database")]
+	if !ok {
+		return nil, "", errorf("The database “%s” does not exist.", params.Get("database"))
+	}
+
+	user := params.Get("user")
+	if _, ok := s.postgreSQLUsers[user]; !ok {
+		return nil, "", errorf("The user “%s” does not exist.", user)
+	}
+
+	return database, user, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,336 @@
+// Package cpaneltest provides a fake cPanel server keeping its state in memory,
+// so that the cPanel clients and the provider can be tested without a real
+// cPanel account.
+package cpaneltest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	Username = "cpaneltest"
+	APIToken = "cpaneltest-token"
+)
+
+// Server is a fake cPanel server serving the UAPI /execute/{module}/{function}
+// endpoint and the API2 /json-api/cpanel endpoint.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]handler
+	faults   map[string][]*Fault
+	calls    []Call
+
+	cronLines []cronLine
+
+	dnsZones map[string]*dnsZone
+
+	subdomains    map[string]*Subdomain
+	addonDomains  map[string]*AddonDomain
+	parkedDomains map[string]bool
+
+	emailAccounts         map[string]*EmailAccount
+	emailForwarders       []EmailForwarder
+	emailDomainForwarders map[string]string
+
+	ftpAccounts map[string]*FTPAccount
+
+	mySQLDatabases map[string]*MySQLDatabase
+	mySQLUsers     map[string]string
+
+	postgreSQLDatabases map[string]*PostgreSQLDatabase
+	postgreSQLUsers     map[string]string
+
+	sshKeys map[string]*SSHKey
+
+	sslCertificates map[string]*SSLCertificate
+	sslKeys         map[string]*sslKey
+	sslCSRs         map[string]*sslCSR
+	sslNextID       int
+
+	autoSSLExcludedDomains map[string]bool
+	autoSSLProblems        []AutoSSLProblem
+	autoSSLCheckInProgress bool
+}
+
+// Call records a request received by the server.
+type Call struct {
+	Module   string
+	Function string
+	Method   string
+	Params   url.Values
+}
+
+// Fault describes an error or latency injected in the responses of a function.
+type Fault struct {
+	// StatusCode answers with this HTTP status instead of calling the function.
+	StatusCode int
+	// Errors answers with a failed API result holding these errors.
+	Errors []string
+	// Latency delays the response.
+	Latency time.Duration
+	// Times is the number of requests affected by the fault, 0 meaning every request.
+	Times int
+}
+
+type api int
+
+const (
+	apiUAPI api = iota
+	apiAPI2
+)
+
+type handler struct {
+	api    api
+	method string
+	fn     func(params url.Values) (interface{}, error)
+}
+
+// apiError is returned by function handlers to answer with a failed API result.
+type apiError struct {
+	messages []string
+}
+
+func (e *apiError) Error() string {
+	return strings.Join(e.messages, ", ")
+}
+
+func errorf(format string, a ...interface{}) error {
+	return &apiError{messages: []string{fmt.Sprintf(format, a...)}}
+}
+
+// NewServer starts a fake cPanel server. It is closed when the test completes.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		handlers:               map[string]handler{},
+		faults:                 map[string][]*Fault{},
+		dnsZones:               map[string]*dnsZone{},
+		subdomains:             map[string]*Subdomain{},
+		addonDomains:           map[string]*AddonDomain{},
+		parkedDomains:          map[string]bool{},
+		emailAccounts:          map[string]*EmailAccount{},
+		emailDomainForwarders:  map[string]string{},
+		ftpAccounts:            map[string]*FTPAccount{},
+		mySQLDatabases:         map[string]*MySQLDatabase{},
+		mySQLUsers:             map[string]string{},
+		postgreSQLDatabases:    map[string]*PostgreSQLDatabase{},
+		postgreSQLUsers:        map[string]string{},
+		sshKeys:                map[string]*SSHKey{},
+		sslCertificates:        map[string]*SSLCertificate{},
+		sslKeys:                map[string]*sslKey{},
+		sslCSRs:                map[string]*sslCSR{},
+		autoSSLExcludedDomains: map[string]bool{},
+	}
+
+	s.registerAutoSSL()
+	s.registerCron()
+	s.registerDNS()
+	s.registerDomains()
+	s.registerEmail()
+	s.registerEmailForwarders()
+	s.registerFTP()
+	s.registerMySQL()
+	s.registerPostgreSQL()
+	s.registerSSH()
+	s.registerSSL()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// ProviderConfig returns a provider block configured to use the server.
+func (s *Server) ProviderConfig() string {
+	return fmt.Sprintf(`
+provider "cpanel" {
+  host        = %q
+  username    = %q
+  api_token   = %q
+  max_retries = 0
+}
+`, s.URL, Username, APIToken)
+}
+
+// InjectFault makes the next calls to module::function fail or slow down.
+func (s *Server) InjectFault(module, function string, fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := functionKey(module, function)
+	s.faults[key] = append(s.faults[key], &fault)
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = map[string][]*Fault{}
+}
+
+// Calls returns the requests received by the server, in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Call(nil), s.calls...)
+}
+
+func (s *Server) register(api api, module, function, method string, fn func(params url.Values) (interface{}, error)) {
+	s.handlers[functionKey(module, function)] = handler{api: api, method: method, fn: fn}
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != fmt.Sprintf("cpanel %s:%s", Username, APIToken) {
+		http.Error(w, "Access denied", http.StatusUnauthorized)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var api api
+	var module, function string
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/execute/"):
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/execute/"), "/")
+		if len(parts) != 2 {
+			http.NotFound(w, r)
+			return
+		}
+		api, module, function = apiUAPI, parts[0], parts[1]
+	case r.URL.Path == "/json-api/cpanel" && r.Form.Get("cpanel_jsonapi_apiversion") == "2":
+		api, module, function = apiAPI2, r.Form.Get("cpanel_jsonapi_module"), r.Form.Get("cpanel_jsonapi_func")
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, Call{Module: module, Function: function, Method: r.Method, Params: r.Form})
+	fault := s.nextFault(module, function)
+	h, ok := s.handlers[functionKey(module, function)]
+	s.mu.Unlock()
+
+	if fault != nil && fault.Latency > 0 {
+		time.Sleep(fault.Latency)
+	}
+
+	if fault != nil && fault.StatusCode != 0 {
+		http.Error(w, http.StatusText(fault.StatusCode), fault.StatusCode)
+		return
+	}
+
+	if !ok || h.api != api {
+		http.Error(w, fmt.Sprintf("unknown function %s::%s", module, function), http.StatusNotFound)
+		return
+	}
+
+	if r.Method != h.method {
+		http.Error(w, fmt.Sprintf("%s::%s must be called with %s", module, function, h.method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var data interface{}
+	var err error
+
+	if fault != nil && len(fault.Errors) > 0 {
+		err = &apiError{messages: fault.Errors}
+	} else {
+		s.mu.Lock()
+		data, err = h.fn(r.Form)
+		s.mu.Unlock()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if api == apiUAPI {
+		_ = json.NewEncoder(w).Encode(uapiResponse(data, err))
+	} else {
+		_ = json.NewEncoder(w).Encode(api2Response(module, function, data, err))
+	}
+}
+
+// nextFault returns the fault to apply to the current call, if any.
+func (s *Server) nextFault(module, function string) *Fault {
+	key := functionKey(module, function)
+	faults := s.faults[key]
+	if len(faults) == 0 {
+		return nil
+	}
+
+	fault := faults[0]
+	if fault.Times > 0 {
+		fault.Times--
+		if fault.Times == 0 {
+			s.faults[key] = faults[1:]
+		}
+	}
+
+	return fault
+}
+
+func uapiResponse(data interface{}, err error) map[string]interface{} {
+	if err != nil {
+		return map[string]interface{}{
+			"status":   0,
+			"errors":   errorMessages(err),
+			"messages": nil,
+			"warnings": nil,
+			"metadata": map[string]interface{}{},
+			"data":     nil,
+		}
+	}
+
+	return map[string]interface{}{
+		"status":   1,
+		"errors":   nil,
+		"messages": nil,
+		"warnings": nil,
+		"metadata": map[string]interface{}{},
+		"data":     data,
+	}
+}
+
+func api2Response(module, function string, data interface{}, err error) map[string]interface{} {
+	result := map[string]interface{}{
+		"apiversion": 2,
+		"module":     module,
+		"func":       function,
+		"event":      map[string]interface{}{"result": 1},
+		"data":       data,
+	}
+
+	if err != nil {
+		result["event"] = map[string]interface{}{"result": 0}
+		result["error"] = strings.Join(errorMessages(err), ", ")
+		result["data"] = []interface{}{}
+	}
+
+	return map[string]interface{}{"cpanelresult": result}
+}
+
+func errorMessages(err error) []string {
+	if apiErr, ok := err.(*apiError); ok {
+		return apiErr.messages
+	}
+
+	return []string{err.Error()}
+}
+
+func functionKey(module, function string) string {
+	return module + "::" + function
+}
@@ -0,0 +1,80 @@
+package cpaneltest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/cron"
+	"terraform-provider-cpanel/internal/cpanel/postgresql"
+)
+
+func newClient(t *testing.T, server *Server, options ...cpanel.ClientOption) *cpanel.Client {
+	t.Helper()
+
+	host, username, apiToken := server.URL, Username, APIToken
+	client, err := cpanel.NewClient(&host, &username, &apiToken, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestServerFaultInjection(t *testing.T) {
+	server := NewServer(t)
+	server.AddPostgreSQLDatabase("user_database")
+	server.InjectFault(cpanel.ModulePostgresql, "list_databases", Fault{StatusCode: 503, Times: 2})
+
+	client := postgresql.NewClient(newClient(t, server, cpanel.WithRetry(2, time.Millisecond)))
+
+	databases, err := client.GetDatabases()
+	if err != nil {
+		t.Fatalf("expected the client to retry until the fault is exhausted, got: %s", err)
+	}
+	if len(databases.Data) != 1 || databases.Data[0].Database != "user_database" {
+		t.Fatalf("unexpected databases: %+v", databases.Data)
+	}
+	if calls := len(server.Calls()); calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestServerErrorInjection(t *testing.T) {
+	server := NewServer(t)
+	server.InjectFault(cpanel.ModuleCron, "add_line", Fault{Errors: []string{"crontab is locked"}, Latency: 50 * time.Millisecond})
+
+	client := cron.NewClient(newClient(t, server))
+
+	start := time.Now()
+	_, err := client.CreateCronJob(cron.CronJobCreateModel{CronJobDetailsModel: cron.CronJobDetailsModel{
+		Command: "ls", Minute: "*", Hour: "*", Day: "*", Month: "*", Weekday: "*",
+	}})
+
+	var api2Error *cpanel.API2Error
+	if !errors.As(err, &api2Error) || api2Error.Messages[0] != "crontab is locked" {
+		t.Fatalf("expected the injected API2 error, got: %v", err)
+	}
+	if time.Since(start) < 50*time.Millisecond {
+		t.Fatal("expected the injected latency to delay the response")
+	}
+	if jobs := server.CronJobs(); len(jobs) != 0 {
+		t.Fatalf("expected no cron job to be created, got: %+v", jobs)
+	}
+}
+
+func TestServerRejectsInvalidCredentials(t *testing.T) {
+	server := NewServer(t)
+
+	host, username, apiToken := server.URL, Username, "invalid"
+	client, err := cpanel.NewClient(&host, &username, &apiToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = postgresql.NewClient(client).GetUsers()
+	if !errors.Is(err, cpanel.ErrAuth) {
+		t.Fatalf("expected an authentication error, got: %v", err)
+	}
+}
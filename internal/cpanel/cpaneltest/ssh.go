@@ -0,0 +1,173 @@
+package cpaneltest
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// SSHKey is a public SSH key of the fake server.
+type SSHKey struct {
+	Name       string
+	Key        string
+	Authorized bool
+}
+
+var sshKeyNamePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// AddSSHKey creates or replaces a public SSH key.
+func (s *Server) AddSSHKey(name, key string, authorized bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sshKeys[name] = &SSHKey{Name: name, Key: key, Authorized: authorized}
+}
+
+// DeleteSSHKey deletes a public SSH key, as if it was done outside Terraform.
+func (s *Server) DeleteSSHKey(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sshKeys, name)
+}
+
+// SetSSHKeyAuthorized authorizes or deauthorizes a public SSH key, as if it was done outside Terraform.
+func (s *Server) SetSSHKeyAuthorized(name string, authorized bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.sshKeys[name]; ok {
+		key.Authorized = authorized
+	}
+}
+
+// SSHKey returns a public SSH key, or nil if it does not exist.
+func (s *Server) SSHKey(name string) *SSHKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.sshKeys[name]
+	if !ok {
+		return nil
+	}
+
+	keyCopy := *key
+
+	return &keyCopy
+}
+
+func (s *Server) registerSSH() {
+	s.register(apiAPI2, cpanel.ModuleSSH, "listkeys", "GET", s.sshListKeys)
+	s.register(apiAPI2, cpanel.ModuleSSH, "fetchkey", "GET", s.sshFetchKey)
+	s.register(apiAPI2, cpanel.ModuleSSH, "importkey", "POST", s.sshImportKey)
+	s.register(apiAPI2, cpanel.ModuleSSH, "authkey", "POST", s.sshAuthKey)
+	s.register(apiAPI2, cpanel.ModuleSSH, "delkey", "POST", s.sshDelKey)
+}
+
+func (s *Server) sshListKeys(params url.Values) (interface{}, error) {
+	data := []interface{}{}
+	if params.Get("pub") != "1" {
+		return data, nil
+	}
+
+	for _, name := range sortedKeys(s.sshKeys) {
+		data = append(data, s.sshKeyData(s.sshKeys[name], false))
+	}
+
+	return data, nil
+}
+
+func (s *Server) sshFetchKey(params url.Values) (interface{}, error) {
+	key, err := s.sshKey(params.Get("name"))
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{s.sshKeyData(key, true)}, nil
+}
+
+func (s *Server) sshImportKey(params url.Values) (interface{}, error) {
+	name, contents := params.Get("name"), strings.TrimSpace(params.Get("key"))
+	if !sshKeyNamePattern.MatchString(name) {
+		return nil, errorf("The key name “%s” is not valid.", name)
+	}
+	if len(strings.Fields(contents)) < 2 || !strings.HasPrefix(contents, "ssh-") && !strings.HasPrefix(contents, "ecdsa-") {
+		return nil, errorf("The key is not a valid public key.")
+	}
+	if _, ok := s.sshKeys[name]; ok {
+		return nil, errorf("The key “%s” already exists.", name)
+	}
+
+	// Imported keys are not authorized until authkey is called
+	s.sshKeys[name] = &SSHKey{Name: name, Key: contents}
+
+	return []interface{}{map[string]interface{}{"name": name}}, nil
+}
+
+func (s *Server) sshAuthKey(params url.Values) (interface{}, error) {
+	key, err := s.sshKey(params.Get("key"))
+	if err != nil {
+		return nil, err
+	}
+
+	switch params.Get("action") {
+	case "authorize":
+		key.Authorized = true
+	case "deauthorize":
+		key.Authorized = false
+	default:
+		return nil, errorf("The action “%s” is not valid.", params.Get("action"))
+	}
+
+	status := "deauthorized"
+	if key.Authorized {
+		status = "authorized"
+	}
+
+	return []interface{}{map[string]interface{}{"name": key.Name, "status": status}}, nil
+}
+
+func (s *Server) sshDelKey(params url.Values) (interface{}, error) {
+	if params.Get("name") == "" {
+		return nil, errorf("The parameter “name” is required.")
+	}
+
+	key, err := s.sshKey(params.Get("name"))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.sshKeys, key.Name)
+
+	return []interface{}{map[string]interface{}{"name": key.Name}}, nil
+}
+
+func (s *Server) sshKey(name string) (*SSHKey, error) {
+	key, ok := s.sshKeys[name]
+	if !ok {
+		return nil, errorf("The key “%s” does not exist.", name)
+	}
+
+	return key, nil
+}
+
+func (s *Server) sshKeyData(key *SSHKey, withContents bool) map[string]interface{} {
+	authStatus := "not authorized"
+	if key.Authorized {
+		authStatus = "authorized"
+	}
+
+	data := map[string]interface{}{
+		"name":       key.Name,
+		"file":       HomeDir + "/.ssh/" + key.Name + ".pub",
+		"haspub":     1,
+		"authstatus": authStatus,
+		"mtime":      1700000000,
+	}
+	if withContents {
+		data["key"] = key.Key
+	}
+
+	return data
+}
@@ -0,0 +1,563 @@
+package cpaneltest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"testing"
+	"time"
+)
+
+// SSLCertificate is a certificate installed on a domain of the fake server.
+type SSLCertificate struct {
+	Domain      string
+	Certificate string
+	Key         string
+	CABundle    string
+}
+
+type sslKey struct {
+	id           string
+	friendlyName string
+	key          crypto.Signer
+	text         string
+}
+
+type sslCSR struct {
+	id                     string
+	friendlyName           string
+	domains                []string
+	countryName            string
+	stateOrProvinceName    string
+	localityName           string
+	organizationName       string
+	organizationalUnitName string
+	emailAddress           string
+	key                    crypto.Signer
+	text                   string
+}
+
+// NewCertificate returns a self-signed certificate for the domains, issued by issuer and
+// expiring at notAfter, along with its private key, both PEM encoded.
+func NewCertificate(t testing.TB, issuer string, notAfter time.Time, domains ...string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := pkix.Name{CommonName: domains[0], Organization: []string{issuer}}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      name,
+		Issuer:       name,
+		DNSNames:     domains,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), keyPEM
+}
+
+// InstallSSLCertificate installs a certificate on a domain, as if it was done outside Terraform.
+func (s *Server) InstallSSLCertificate(domain, certificate, key, caBundle string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sslCertificates[domain] = &SSLCertificate{Domain: domain, Certificate: certificate, Key: key, CABundle: caBundle}
+}
+
+// DeleteSSLCertificate removes the certificate of a domain, as if it was done outside Terraform.
+func (s *Server) DeleteSSLCertificate(domain string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sslCertificates, domain)
+}
+
+// SSLCertificate returns the certificate installed on a domain, or nil if there is none.
+func (s *Server) SSLCertificate(domain string) *SSLCertificate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	certificate, ok := s.sslCertificates[domain]
+	if !ok {
+		return nil
+	}
+
+	certificateCopy := *certificate
+
+	return &certificateCopy
+}
+
+// SSLKeyIDs returns the identifiers of the private keys, sorted.
+func (s *Server) SSLKeyIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedKeys(s.sslKeys)
+}
+
+// DeleteSSLKey deletes a private key, as if it was done outside Terraform.
+func (s *Server) DeleteSSLKey(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sslKeys, id)
+}
+
+// SSLKeyExists reports whether a private key exists.
+func (s *Server) SSLKeyExists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.sslKeys[id]
+
+	return ok
+}
+
+// SSLCSRIDs returns the identifiers of the certificate signing requests, sorted.
+func (s *Server) SSLCSRIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedKeys(s.sslCSRs)
+}
+
+// DeleteSSLCSR deletes a certificate signing request, as if it was done outside Terraform.
+func (s *Server) DeleteSSLCSR(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sslCSRs, id)
+}
+
+// SSLCSRExists reports whether a certificate signing request exists.
+func (s *Server) SSLCSRExists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.sslCSRs[id]
+
+	return ok
+}
+
+func (s *Server) registerSSL() {
+	s.register(apiUAPI, cpanel.ModuleSSL, "install_ssl", "POST", s.sslInstallSSL)
+	s.register(apiUAPI, cpanel.ModuleSSL, "installed_hosts", "GET", s.sslInstalledHosts)
+	s.register(apiUAPI, cpanel.ModuleSSL, "fetch_key_and_cabundle_for_certificate", "GET", s.sslFetchKeyAndCABundle)
+	s.register(apiUAPI, cpanel.ModuleSSL, "delete_ssl", "POST", s.sslDeleteSSL)
+	s.register(apiUAPI, cpanel.ModuleSSL, "generate_key", "POST", s.sslGenerateKey)
+	s.register(apiUAPI, cpanel.ModuleSSL, "list_keys", "GET", s.sslListKeys)
+	s.register(apiUAPI, cpanel.ModuleSSL, "show_key", "GET", s.sslShowKey)
+	s.register(apiUAPI, cpanel.ModuleSSL, "delete_key", "POST", s.sslDeleteKey)
+	s.register(apiUAPI, cpanel.ModuleSSL, "generate_csr", "POST", s.sslGenerateCSR)
+	s.register(apiUAPI, cpanel.ModuleSSL, "show_csr", "GET", s.sslShowCSR)
+	s.register(apiUAPI, cpanel.ModuleSSL, "delete_csr", "POST", s.sslDeleteCSR)
+}
+
+func (s *Server) sslInstallSSL(params url.Values) (interface{}, error) {
+	domain := params.Get("domain")
+	if _, subdomain := s.subdomains[domain]; !s.domainExists(domain) && !subdomain {
+		return nil, errorf("The domain “%s” does not exist.", domain)
+	}
+
+	certificate, err := parseCertificate(params.Get("cert"))
+	if err != nil {
+		return nil, errorf("The certificate could not be parsed: %s", err)
+	}
+
+	key, err := parseKey(params.Get("key"))
+	if err != nil {
+		return nil, errorf("The key could not be parsed: %s", err)
+	}
+
+	if publicKeyID(certificate.PublicKey) != publicKeyID(key.Public()) {
+		return nil, errorf("The certificate does not match the key.")
+	}
+
+	s.sslCertificates[domain] = &SSLCertificate{
+		Domain:      domain,
+		Certificate: params.Get("cert"),
+		Key:         params.Get("key"),
+		CABundle:    params.Get("cabundle"),
+	}
+
+	return map[string]interface{}{
+		"action":          "install",
+		"cert_id":         certificateID(domain, certificate),
+		"domain":          domain,
+		"servername":      domain,
+		"message":         "The SSL certificate is now installed onto the domain “" + domain + "”.",
+		"working_domains": certificate.DNSNames,
+		"warning_domains": []string{},
+	}, nil
+}
+
+func (s *Server) sslInstalledHosts(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, domain := range sortedKeys(s.sslCertificates) {
+		installed := s.sslCertificates[domain]
+		certificate, err := parseCertificate(installed.Certificate)
+		if err != nil {
+			return nil, err
+		}
+
+		issuerOrganizationName := ""
+		if len(certificate.Issuer.Organization) > 0 {
+			issuerOrganizationName = certificate.Issuer.Organization[0]
+		}
+
+		data = append(data, map[string]interface{}{
+			"servername": domain,
+			"fqdns":      []string{domain, "www." + domain},
+			"docroot":    HomeDir + "/public_html",
+			"certificate": map[string]interface{}{
+				"id":                      certificateID(domain, certificate),
+				"domains":                 certificate.DNSNames,
+				"not_before":              certificate.NotBefore.Unix(),
+				"not_after":               certificate.NotAfter.Unix(),
+				"issuer.organizationName": issuerOrganizationName,
+				"issuer.commonName":       certificate.Issuer.CommonName,
+				"subject.commonName":      certificate.Subject.CommonName,
+				"is_self_signed":          boolToInt(bytes.Equal(certificate.RawIssuer, certificate.RawSubject)),
+			},
+			"certificate_text": installed.Certificate,
+		})
+	}
+
+	return data, nil
+}
+
+func (s *Server) sslFetchKeyAndCABundle(params url.Values) (interface{}, error) {
+	for _, domain := range sortedKeys(s.sslCertificates) {
+		installed := s.sslCertificates[domain]
+		if strings.TrimSpace(installed.Certificate) == strings.TrimSpace(params.Get("certificate")) {
+			return map[string]interface{}{
+				"certificate": installed.Certificate,
+				"key":         installed.Key,
+				"cab":         installed.CABundle,
+			}, nil
+		}
+	}
+
+	return nil, errorf("The key of the certificate was not found.")
+}
+
+func (s *Server) sslDeleteSSL(params url.Values) (interface{}, error) {
+	domain := params.Get("domain")
+	if _, ok := s.sslCertificates[domain]; !ok {
+		return nil, errorf("The SSL host “%s” does not exist.", domain)
+	}
+
+	delete(s.sslCertificates, domain)
+
+	return nil, nil
+}
+
+func (s *Server) sslGenerateKey(params url.Values) (interface{}, error) {
+	var key crypto.Signer
+	var err error
+
+	keyType := params.Get("key_type")
+	switch keyType {
+	case "", "rsa-2048":
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "rsa-4096":
+		key, err = rsa.GenerateKey(rand.Reader, 4096)
+	case "ecdsa-prime256v1":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case "ecdsa-secp384r1":
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		return nil, errorf("“%s” is not a valid key type.", keyType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sslNextID++
+	id := fmt.Sprintf("%s_%d", publicKeyID(key.Public())[:10], s.sslNextID)
+
+	friendlyName := params.Get("friendly_name")
+	if friendlyName == "" {
+		friendlyName = "Key generated " + id
+	}
+	for _, existing := range s.sslKeys {
+		if existing.friendlyName == friendlyName {
+			return nil, errorf("A key with the friendly name “%s” already exists.", friendlyName)
+		}
+	}
+
+	s.sslKeys[id] = &sslKey{id: id, friendlyName: friendlyName, key: key, text: text}
+
+	data := keyData(s.sslKeys[id])
+	data["text"] = text
+
+	return data, nil
+}
+
+func (s *Server) sslListKeys(_ url.Values) (interface{}, error) {
+	data := []interface{}{}
+
+	for _, id := range sortedKeys(s.sslKeys) {
+		data = append(data, keyData(s.sslKeys[id]))
+	}
+
+	return data, nil
+}
+
+func (s *Server) sslShowKey(params url.Values) (interface{}, error) {
+	key, ok := s.sslKeys[params.Get("id")]
+	if !ok {
+		return nil, errorf("The key “%s” does not exist.", params.Get("id"))
+	}
+
+	return map[string]interface{}{
+		"key":  keyData(key),
+		"text": key.text,
+	}, nil
+}
+
+func (s *Server) sslDeleteKey(params url.Values) (interface{}, error) {
+	if _, ok := s.sslKeys[params.Get("id")]; !ok {
+		return nil, errorf("The key “%s” does not exist.", params.Get("id"))
+	}
+
+	delete(s.sslKeys, params.Get("id"))
+
+	return nil, nil
+}
+
+func (s *Server) sslGenerateCSR(params url.Values) (interface{}, error) {
+	key, ok := s.sslKeys[params.Get("key_id")]
+	if !ok {
+		return nil, errorf("The key “%s” does not exist.", params.Get("key_id"))
+	}
+
+	csr := &sslCSR{
+		domains:                strings.Split(params.Get("domains"), ","),
+		countryName:            params.Get("countryName"),
+		stateOrProvinceName:    params.Get("stateOrProvinceName"),
+		localityName:           params.Get("localityName"),
+		organizationName:       params.Get("organizationName"),
+		organizationalUnitName: params.Get("organizationalUnitName"),
+		emailAddress:           params.Get("emailAddress"),
+		key:                    key.key,
+	}
+
+	if params.Get("domains") == "" {
+		return nil, errorf("You must specify at least one domain.")
+	}
+	if len(csr.countryName) != 2 {
+		return nil, errorf("The country name must be a two-letter country code.")
+	}
+	if csr.stateOrProvinceName == "" || csr.localityName == "" || csr.organizationName == "" {
+		return nil, errorf("The state, the locality and the organization are required.")
+	}
+
+	subject := pkix.Name{
+		CommonName:   csr.domains[0],
+		Country:      []string{csr.countryName},
+		Province:     []string{csr.stateOrProvinceName},
+		Locality:     []string{csr.localityName},
+		Organization: []string{csr.organizationName},
+	}
+	if csr.organizationalUnitName != "" {
+		subject.OrganizationalUnit = []string{csr.organizationalUnitName}
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: subject, DNSNames: csr.domains}, key.key)
+	if err != nil {
+		return nil, err
+	}
+	csr.text = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+
+	s.sslNextID++
+	csr.id = fmt.Sprintf("%s_%d", strings.ReplaceAll(csr.domains[0], ".", "_"), s.sslNextID)
+	csr.friendlyName = params.Get("friendly_name")
+	if csr.friendlyName == "" {
+		csr.friendlyName = csr.domains[0] + " " + csr.id
+	}
+
+	s.sslCSRs[csr.id] = csr
+
+	data := csrData(csr)
+	data["text"] = csr.text
+
+	return data, nil
+}
+
+func (s *Server) sslShowCSR(params url.Values) (interface{}, error) {
+	csr, ok := s.sslCSRs[params.Get("id")]
+	if !ok {
+		return nil, errorf("The certificate signing request “%s” does not exist.", params.Get("id"))
+	}
+
+	return map[string]interface{}{
+		"details": csrData(csr),
+		"text":    csr.text,
+	}, nil
+}
+
+func (s *Server) sslDeleteCSR(params url.Values) (interface{}, error) {
+	if _, ok := s.sslCSRs[params.Get("id")]; !ok {
+		return nil, errorf("The certificate signing request “%s” does not exist.", params.Get("id"))
+	}
+
+	delete(s.sslCSRs, params.Get("id"))
+
+	return nil, nil
+}
+
+func keyData(key *sslKey) map[string]interface{} {
+	data := map[string]interface{}{
+		"id":            key.id,
+		"friendly_name": key.friendlyName,
+		"created":       time.Now().Unix(),
+	}
+
+	switch public := key.key.Public().(type) {
+	case *rsa.PublicKey:
+		data["key_algorithm"] = "rsaEncryption"
+		data["modulus"] = hex.EncodeToString(public.N.Bytes())
+		data["modulus_length"] = public.N.BitLen()
+	case *ecdsa.PublicKey:
+		data["key_algorithm"] = "id-ecPublicKey"
+		data["ecdsa_public"] = publicKeyID(public)
+		data["ecdsa_curve_name"] = map[string]string{"P-256": "prime256v1", "P-384": "secp384r1"}[public.Curve.Params().Name]
+	}
+
+	return data
+}
+
+func csrData(csr *sslCSR) map[string]interface{} {
+	data := map[string]interface{}{
+		"id":                     csr.id,
+		"friendly_name":          csr.friendlyName,
+		"commonName":             csr.domains[0],
+		"domains":                csr.domains,
+		"countryName":            csr.countryName,
+		"stateOrProvinceName":    csr.stateOrProvinceName,
+		"localityName":           csr.localityName,
+		"organizationName":       csr.organizationName,
+		"organizationalUnitName": csr.organizationalUnitName,
+		"emailAddress":           csr.emailAddress,
+		"created":                time.Now().Unix(),
+	}
+
+	switch public := csr.key.Public().(type) {
+	case *rsa.PublicKey:
+		data["modulus"] = hex.EncodeToString(public.N.Bytes())
+	case *ecdsa.PublicKey:
+		data["ecdsa_public"] = publicKeyID(public)
+	}
+
+	return data
+}
+
+func parseCertificate(text string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(text))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func parseKey(text string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(text))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded key found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+
+	return signer, nil
+}
+
+func encodeKey(key crypto.Signer) (string, error) {
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})), nil
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return "", err
+		}
+		return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+	}
+
+	return "", fmt.Errorf("unsupported key type %T", key)
+}
+
+// publicKeyID identifies a public key by the hash of its DER encoding.
+func publicKeyID(key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha1.Sum(der)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func certificateID(domain string, certificate *x509.Certificate) string {
+	return fmt.Sprintf("%s_%s_%d", strings.ReplaceAll(domain, ".", "_"), publicKeyID(certificate.PublicKey)[:10], certificate.NotAfter.Unix())
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
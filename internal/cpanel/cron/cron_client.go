@@ -14,6 +14,6 @@ func NewClient(c *cpanel.Client) *Client {
 	}
 }
 
-func (c *Client) executeOperation(function string, queryParams map[string]string, inputModel interface{}) error {
-	return c.Client.ExecuteAPI2Operation(cpanel.ModuleCron, function, queryParams, inputModel)
+func (c *Client) executeOperation(operation cpanel.Operation, params map[string]string, inputModel interface{}) error {
+	return c.Client.ExecuteAPI2Operation(cpanel.ModuleCron, operation, params, inputModel)
 }
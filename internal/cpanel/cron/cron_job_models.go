@@ -1,6 +1,10 @@
 package cron
 
-import "terraform-provider-cpanel/internal/cpanel"
+import (
+	"regexp"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
 
 type CronJobDataSourceModel struct {
 	CpanelResult CronJobCpanelResultModel `tfsdk:"cpanelresult"`
@@ -79,3 +83,58 @@ type CronJobCommonDataSourceDataModel struct {
 	Reason    string `tfsdk:"reason"`
 	Result    int64  `tfsdk:"result"`
 }
+
+// markerPattern matches the comment identifying a cron job managed by Terraform, at the end of its command.
+var markerPattern = regexp.MustCompile(`\s*# tf:([0-9a-f-]+)\s*$`)
+
+// MarkCommand appends the comment identifying the cron job to its command. The
+// comment is kept in the crontab, so the job can be found again after its
+// schedule or command have been edited outside Terraform.
+func MarkCommand(command, id string) string {
+	return strings.TrimSpace(command) + " # tf:" + id
+}
+
+// SplitCommand returns the command without its identifying comment, and the
+// identifier found in the comment, empty if there is none.
+func SplitCommand(command string) (string, string) {
+	match := markerPattern.FindStringSubmatchIndex(command)
+	if match == nil {
+		return command, ""
+	}
+
+	return command[:match[0]], command[match[2]:match[3]]
+}
+
+// FindByID returns the cron job whose command holds the identifier, or nil if there is none.
+func (m *CronJobDataSourceModel) FindByID(id string) *CronJobDataSourceDataModel {
+	for _, data := range m.CpanelResult.Data {
+		if _, commandID := SplitCommand(data.Command); commandID == id {
+			return &data
+		}
+	}
+
+	return nil
+}
+
+// FindByLineKey returns the command line with the line key, or nil if there is none.
+func (m *CronJobDataSourceModel) FindByLineKey(lineKey int64) *CronJobDataSourceDataModel {
+	for _, data := range m.CpanelResult.Data {
+		if data.Type == "command" && data.LineKey == lineKey {
+			return &data
+		}
+	}
+
+	return nil
+}
+
+// FindByCommandNumber returns the command line with the command number, counting command lines only,
+// or nil if there is none.
+func (m *CronJobDataSourceModel) FindByCommandNumber(commandNumber int64) *CronJobDataSourceDataModel {
+	for _, data := range m.CpanelResult.Data {
+		if data.Type == "command" && data.CommandNumber == commandNumber {
+			return &data
+		}
+	}
+
+	return nil
+}
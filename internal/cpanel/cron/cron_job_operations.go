@@ -1,8 +1,10 @@
 package cron
 
-const (
-	OperationAddLine    = "add_line"
-	OperationEditLine   = "edit_line"
-	OperationFetchCron  = "fetchcron"
-	OperationRemoveLine = "remove_line"
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationAddLine    = cpanel.WriteOperation("add_line")
+	OperationEditLine   = cpanel.WriteOperation("edit_line")
+	OperationFetchCron  = cpanel.ReadOperation("fetchcron")
+	OperationRemoveLine = cpanel.WriteOperation("remove_line")
 )
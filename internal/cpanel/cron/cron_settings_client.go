@@ -0,0 +1,64 @@
+package cron
+
+import "strconv"
+
+// GetEmail returns the address the output of the cron jobs is sent to, empty if there is none.
+func (c *Client) GetEmail() (string, error) {
+	email := EmailDataSourceModel{}
+	err := c.executeOperation(OperationGetEmail, map[string]string{}, &email)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(email.CpanelResult.Data) == 0 {
+		return "", nil
+	}
+
+	return email.CpanelResult.Data[0].Email, nil
+}
+
+func (c *Client) SetEmail(input EmailUpdateModel) (*EmailUpdateDataSourceModel, error) {
+	email := EmailUpdateDataSourceModel{}
+	err := c.executeOperation(OperationSetEmail, map[string]string{
+		"email": input.Email,
+	}, &email)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &email, nil
+}
+
+// AddVariable adds a variable line such as PATH=/usr/bin:/bin to the crontab.
+func (c *Client) AddVariable(input VariableCreateModel) (*CronJobCreateDataSourceModel, error) {
+	variable := CronJobCreateDataSourceModel{}
+	err := c.executeOperation(OperationAddLine, map[string]string{
+		"key":   input.Key,
+		"value": input.Value,
+	}, &variable)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &variable, nil
+}
+
+// UpdateVariable replaces the variable line of the crontab with the given line key. Variable lines are
+// removed with DeleteCronJob, like command lines.
+func (c *Client) UpdateVariable(input VariableUpdateModel) (*CronJobCreateDataSourceModel, error) {
+	variable := CronJobCreateDataSourceModel{}
+	err := c.executeOperation(OperationEditLine, map[string]string{
+		"linekey": strconv.FormatInt(input.LineKey, 10),
+		"key":     input.Key,
+		"value":   input.Value,
+	}, &variable)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &variable, nil
+}
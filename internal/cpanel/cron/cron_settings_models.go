@@ -0,0 +1,62 @@
+package cron
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type EmailDataSourceModel struct {
+	CpanelResult EmailCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+type EmailCpanelResultModel struct {
+	cpanel.API2DataSourceCpanelResultModel
+	Data []EmailDataSourceDataModel `tfsdk:"data"`
+}
+
+type EmailDataSourceDataModel struct {
+	Email string `tfsdk:"email"`
+}
+
+type EmailUpdateModel struct {
+	Email string `tfsdk:"email"`
+}
+
+type EmailUpdateDataSourceModel struct {
+	CpanelResult EmailUpdateCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+type EmailUpdateCpanelResultModel struct {
+	cpanel.API2DataSourceCpanelResultModel
+	Data []CronJobCommonDataSourceDataModel `tfsdk:"data"`
+}
+
+type VariableCreateModel struct {
+	Key   string `tfsdk:"key"`
+	Value string `tfsdk:"value"`
+}
+
+type VariableUpdateModel struct {
+	LineKey int64 `tfsdk:"linekey"`
+	VariableCreateModel
+}
+
+// Variables returns the variables set in the crontab, such as SHELL or PATH, by name.
+func (m *CronJobDataSourceModel) Variables() map[string]string {
+	variables := map[string]string{}
+	for _, data := range m.CpanelResult.Data {
+		if data.Type != "command" && data.Key != "" {
+			variables[data.Key] = data.Value
+		}
+	}
+
+	return variables
+}
+
+// FindVariable returns the line setting the variable, or nil if it is not set.
+func (m *CronJobDataSourceModel) FindVariable(key string) *CronJobDataSourceDataModel {
+	for _, data := range m.CpanelResult.Data {
+		if data.Type != "command" && data.Key == key {
+			return &data
+		}
+	}
+
+	return nil
+}
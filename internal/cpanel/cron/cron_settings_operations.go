@@ -0,0 +1,8 @@
+package cron
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationGetEmail = cpanel.ReadOperation("get_email")
+	OperationSetEmail = cpanel.WriteOperation("set_email")
+)
@@ -0,0 +1,189 @@
+package dns
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// maxSerialRetries is the number of times EditZone starts over when the zone changed
+// between its read and its update.
+const maxSerialRetries = 3
+
+// GetZone parses the zone of the domain, and returns its records and serial.
+func (c *Client) GetZone(zone string) (*Zone, error) {
+	dnsZone := ZoneDataSourceModel{}
+	err := c.executeOperation(OperationParseZone, map[string]string{"zone": zone}, &dnsZone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeZone(zone, &dnsZone)
+}
+
+// MassEditZone applies the changes to the zone in a single operation. cPanel
+// rejects them if the zone serial changed since it was read.
+func (c *Client) MassEditZone(input ZoneMassEditModel) (*ZoneMassEditDataSourceModel, error) {
+	params := map[string]string{
+		"zone":   input.Zone,
+		"serial": input.Serial,
+	}
+
+	for i, record := range input.Add {
+		add, err := json.Marshal(map[string]interface{}{
+			"dname":       record.Name,
+			"ttl":         record.TTL,
+			"record_type": record.Type,
+			"data":        record.Data,
+		})
+		if err != nil {
+			return nil, err
+		}
+		params[multiValueParam("add", i)] = string(add)
+	}
+
+	for i, record := range input.Edit {
+		edit, err := json.Marshal(map[string]interface{}{
+			"line_index":  record.LineIndex,
+			"dname":       record.Name,
+			"ttl":         record.TTL,
+			"record_type": record.Type,
+			"data":        record.Data,
+		})
+		if err != nil {
+			return nil, err
+		}
+		params[multiValueParam("edit", i)] = string(edit)
+	}
+
+	for i, lineIndex := range input.Remove {
+		params[multiValueParam("remove", i)] = strconv.FormatInt(lineIndex, 10)
+	}
+
+	dnsZone := ZoneMassEditDataSourceModel{}
+	err := c.executeOperation(OperationMassEditZone, params, &dnsZone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &dnsZone, nil
+}
+
+// EditZone reads the zone, computes the changes to make with edit and applies them.
+// It starts over when the zone was changed in the meantime, until maxSerialRetries.
+// Changes are not applied when edit returns nil.
+func (c *Client) EditZone(zone string, edit func(dnsZone *Zone) (*ZoneMassEditModel, error)) error {
+	for attempt := 0; ; attempt++ {
+		dnsZone, err := c.GetZone(zone)
+		if err != nil {
+			return err
+		}
+
+		changes, err := edit(dnsZone)
+		if err != nil || changes == nil {
+			return err
+		}
+
+		changes.Zone = zone
+		changes.Serial = dnsZone.Serial
+
+		_, err = c.MassEditZone(*changes)
+		if err == nil || !IsSerialMismatch(err) || attempt >= maxSerialRetries {
+			return err
+		}
+	}
+}
+
+// IsSerialMismatch reports whether the error comes from a zone changed since it was read.
+func IsSerialMismatch(err error) bool {
+	var uapiError *cpanel.UAPIError
+	if !errors.As(err, &uapiError) {
+		return false
+	}
+
+	for _, message := range uapiError.Errors {
+		if strings.Contains(strings.ToLower(message), "serial") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FQDN returns the fully qualified name, with a trailing dot, of a name relative to
+// the zone. "@" and "" stand for the zone itself, and names ending with a dot are
+// already fully qualified.
+func FQDN(name, zone string) string {
+	zone = strings.TrimSuffix(strings.ToLower(zone), ".")
+	name = strings.ToLower(name)
+
+	switch {
+	case name == "@" || name == "":
+		return zone + "."
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + zone + "."
+	}
+}
+
+// multiValueParam returns the name of the i-th value of a UAPI parameter
+// accepting several values: name, name-1, name-2...
+func multiValueParam(name string, i int) string {
+	if i == 0 {
+		return name
+	}
+
+	return fmt.Sprintf("%s-%d", name, i)
+}
+
+func decodeZone(zoneName string, dnsZone *ZoneDataSourceModel) (*Zone, error) {
+	zone := &Zone{}
+
+	for _, line := range dnsZone.Data {
+		if line.Type != LineTypeRecord {
+			continue
+		}
+
+		name, err := base64.StdEncoding.DecodeString(line.DnameB64)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode the name of the record on line %d: %w", line.LineIndex, err)
+		}
+
+		data := make([]string, 0, len(line.DataB64))
+		for _, dataB64 := range line.DataB64 {
+			value, err := base64.StdEncoding.DecodeString(dataB64)
+			if err != nil {
+				return nil, fmt.Errorf("could not decode the data of the record on line %d: %w", line.LineIndex, err)
+			}
+			data = append(data, string(value))
+		}
+
+		record := Record{
+			LineIndex: line.LineIndex,
+			Name:      FQDN(string(name), zoneName),
+			TTL:       line.TTL,
+			Type:      strings.ToUpper(line.RecordType),
+			Data:      data,
+		}
+
+		// The serial is the third field of the SOA record
+		if record.Type == "SOA" && len(record.Data) > 2 {
+			zone.Serial = record.Data[2]
+		}
+
+		zone.Records = append(zone.Records, record)
+	}
+
+	if zone.Serial == "" {
+		return nil, errors.New("could not find the serial of the zone")
+	}
+
+	return zone, nil
+}
@@ -0,0 +1,58 @@
+package dns
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+// Types of the lines returned by parse_zone.
+const (
+	LineTypeRecord  = "record"
+	LineTypeComment = "comment"
+	LineTypeControl = "control"
+)
+
+type ZoneDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []ZoneDataSourceDataModel `tfsdk:"data"`
+}
+
+// ZoneDataSourceDataModel is a line of the zone file, with its name and data base64-encoded.
+type ZoneDataSourceDataModel struct {
+	LineIndex  int64    `json:"line_index" tfsdk:"line_index"`
+	Type       string   `tfsdk:"type"`
+	RecordType string   `json:"record_type" tfsdk:"record_type"`
+	DnameB64   string   `json:"dname_b64" tfsdk:"dname_b64"`
+	TTL        int64    `tfsdk:"ttl"`
+	DataB64    []string `json:"data_b64" tfsdk:"data_b64"`
+	TextB64    string   `json:"text_b64" tfsdk:"text_b64"`
+}
+
+// Zone is a decoded zone, along with the serial to send back with its changes.
+type Zone struct {
+	Serial  string
+	Records []Record
+}
+
+// Record is a resource record of a zone. Name is fully qualified, with a trailing dot.
+type Record struct {
+	LineIndex int64
+	Name      string
+	TTL       int64
+	Type      string
+	Data      []string
+}
+
+type ZoneMassEditModel struct {
+	Zone   string   `tfsdk:"zone"`
+	Serial string   `tfsdk:"serial"`
+	Add    []Record `tfsdk:"add"`
+	Edit   []Record `tfsdk:"edit"`
+	Remove []int64  `tfsdk:"remove"`
+}
+
+type ZoneMassEditDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data ZoneMassEditDataSourceDataModel `tfsdk:"data"`
+}
+
+type ZoneMassEditDataSourceDataModel struct {
+	NewSerial string `json:"new_serial" tfsdk:"new_serial"`
+}
@@ -0,0 +1,8 @@
+package dns
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationMassEditZone = cpanel.WriteOperation("mass_edit_zone")
+	OperationParseZone    = cpanel.ReadOperation("parse_zone")
+)
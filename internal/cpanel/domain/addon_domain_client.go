@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+func (c *Client) CreateAddonDomain(input AddonDomainCreateModel) (*ResultDataSourceModel, error) {
+	result := ResultDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModuleAddonDomain, OperationAddAddonDomain, map[string]string{
+		"newdomain": input.Domain,
+		"subdomain": input.Subdomain,
+		"dir":       input.Dir,
+	}, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) GetAddonDomains() (*AddonDomainDataSourceModel, error) {
+	addonDomains := AddonDomainDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModuleAddonDomain, OperationListAddonDomains, map[string]string{}, &addonDomains)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &addonDomains, nil
+}
+
+// GetAddonDomain returns an addon domain, or nil if it does not exist.
+func (c *Client) GetAddonDomain(domain string) (*AddonDomainDataSourceDataModel, error) {
+	addonDomains, err := c.GetAddonDomains()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, addonDomain := range addonDomains.CpanelResult.Data {
+		if strings.EqualFold(addonDomain.Domain, domain) {
+			return &addonDomains.CpanelResult.Data[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) DeleteAddonDomain(input AddonDomainDeleteModel) (*ResultDataSourceModel, error) {
+	result := ResultDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModuleAddonDomain, OperationDeleteAddonDomain, map[string]string{
+		"domain": input.Domain,
+		// The backing subdomain is given as subdomain_rootdomain
+		"subdomain": input.Subdomain + "_" + input.RootDomain,
+	}, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type AddonDomainDataSourceModel struct {
+	CpanelResult AddonDomainCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+type AddonDomainCpanelResultModel struct {
+	cpanel.API2DataSourceCpanelResultModel
+	Data []AddonDomainDataSourceDataModel `tfsdk:"data"`
+}
+
+// AddonDomainDataSourceDataModel is an addon domain, backed by the subdomain Subdomain
+// of the main domain RootDomain.
+type AddonDomainDataSourceDataModel struct {
+	Domain        string `tfsdk:"domain"`
+	Subdomain     string `tfsdk:"subdomain"`
+	RootDomain    string `tfsdk:"rootdomain"`
+	FullSubdomain string `tfsdk:"fullsubdomain"`
+	Dir           string `tfsdk:"dir"`
+	BaseDir       string `tfsdk:"basedir"`
+}
+
+type AddonDomainCreateModel struct {
+	Domain    string `tfsdk:"newdomain"`
+	Subdomain string `tfsdk:"subdomain"`
+	Dir       string `tfsdk:"dir"`
+}
+
+type AddonDomainDeleteModel struct {
+	Domain     string `tfsdk:"domain"`
+	Subdomain  string `tfsdk:"subdomain"`
+	RootDomain string `tfsdk:"rootdomain"`
+}
@@ -0,0 +1,9 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationAddAddonDomain    = cpanel.WriteOperation("addaddondomain")
+	OperationListAddonDomains  = cpanel.ReadOperation("listaddondomains")
+	OperationDeleteAddonDomain = cpanel.WriteOperation("deladdondomain")
+)
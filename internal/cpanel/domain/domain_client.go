@@ -0,0 +1,23 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+// Client manages the domains of the account, which are spread over the SubDomain,
+// AddonDomain, Park and DomainInfo modules.
+type Client struct {
+	*cpanel.Client
+}
+
+func NewClient(c *cpanel.Client) *Client {
+	return &Client{
+		Client: c,
+	}
+}
+
+func (c *Client) executeUAPIOperation(module string, operation cpanel.Operation, params map[string]string, inputModel interface{}) error {
+	return c.Client.ExecuteUAPIOperation(module, operation, params, inputModel)
+}
+
+func (c *Client) executeAPI2Operation(module string, operation cpanel.Operation, params map[string]string, inputModel interface{}) error {
+	return c.Client.ExecuteAPI2Operation(module, operation, params, inputModel)
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+func (c *Client) GetDomains() (*DomainsDataSourceModel, error) {
+	domains := DomainsDataSourceModel{}
+	err := c.executeUAPIOperation(cpanel.ModuleDomainInfo, OperationDomainsData, map[string]string{"format": "hash"}, &domains)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domains, nil
+}
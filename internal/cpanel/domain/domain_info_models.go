@@ -0,0 +1,24 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type DomainsDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data DomainsDataSourceDataModel `tfsdk:"data"`
+}
+
+// DomainsDataSourceDataModel lists the domains of the account. Parked domains share the
+// document root of the main domain, so only their names are returned.
+type DomainsDataSourceDataModel struct {
+	MainDomain    DomainDataSourceDataModel   `json:"main_domain" tfsdk:"main_domain"`
+	AddonDomains  []DomainDataSourceDataModel `json:"addon_domains" tfsdk:"addon_domains"`
+	ParkedDomains []string                    `json:"parked_domains" tfsdk:"parked_domains"`
+	SubDomains    []DomainDataSourceDataModel `json:"sub_domains" tfsdk:"sub_domains"`
+}
+
+type DomainDataSourceDataModel struct {
+	Domain       string `tfsdk:"domain"`
+	DocumentRoot string `tfsdk:"documentroot"`
+	HomeDir      string `tfsdk:"homedir"`
+	PHPVersion   string `tfsdk:"phpversion"`
+}
@@ -0,0 +1,7 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationDomainsData = cpanel.ReadOperation("domains_data")
+)
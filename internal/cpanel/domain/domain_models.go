@@ -0,0 +1,19 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+// ResultDataSourceModel is returned by the API2 functions changing domains, which
+// report their result along with its reason.
+type ResultDataSourceModel struct {
+	CpanelResult ResultCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+type ResultCpanelResultModel struct {
+	cpanel.API2DataSourceCpanelResultModel
+	Data []ResultDataSourceDataModel `tfsdk:"data"`
+}
+
+type ResultDataSourceDataModel struct {
+	Result int64  `tfsdk:"result"`
+	Reason string `tfsdk:"reason"`
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+func (c *Client) CreateParkedDomain(input ParkedDomainCreateModel) (*ResultDataSourceModel, error) {
+	result := ResultDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModulePark, OperationParkDomain, map[string]string{
+		"domain": input.Domain,
+	}, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) GetParkedDomains() (*ParkedDomainDataSourceModel, error) {
+	parkedDomains := ParkedDomainDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModulePark, OperationListParkedDomains, map[string]string{}, &parkedDomains)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &parkedDomains, nil
+}
+
+// GetParkedDomain returns a parked domain, or nil if it does not exist.
+func (c *Client) GetParkedDomain(domain string) (*ParkedDomainDataSourceDataModel, error) {
+	parkedDomains, err := c.GetParkedDomains()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, parkedDomain := range parkedDomains.CpanelResult.Data {
+		if strings.EqualFold(parkedDomain.Domain, domain) {
+			return &parkedDomains.CpanelResult.Data[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) DeleteParkedDomain(input ParkedDomainDeleteModel) (*ResultDataSourceModel, error) {
+	result := ResultDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModulePark, OperationUnparkDomain, map[string]string{
+		"domain": input.Domain,
+	}, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type ParkedDomainDataSourceModel struct {
+	CpanelResult ParkedDomainCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+type ParkedDomainCpanelResultModel struct {
+	cpanel.API2DataSourceCpanelResultModel
+	Data []ParkedDomainDataSourceDataModel `tfsdk:"data"`
+}
+
+// ParkedDomainDataSourceDataModel is a parked domain, also known as an alias, serving
+// the document root of the main domain.
+type ParkedDomainDataSourceDataModel struct {
+	Domain  string `tfsdk:"domain"`
+	Dir     string `tfsdk:"dir"`
+	BaseDir string `tfsdk:"basedir"`
+}
+
+type ParkedDomainCreateModel struct {
+	Domain string `tfsdk:"domain"`
+}
+
+type ParkedDomainDeleteModel struct {
+	Domain string `tfsdk:"domain"`
+}
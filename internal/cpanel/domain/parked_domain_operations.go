@@ -0,0 +1,9 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationParkDomain        = cpanel.WriteOperation("park")
+	OperationListParkedDomains = cpanel.ReadOperation("listparkeddomains")
+	OperationUnparkDomain      = cpanel.WriteOperation("unpark")
+)
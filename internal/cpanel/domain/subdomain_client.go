@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+func (c *Client) CreateSubdomain(input SubdomainCreateModel) (*SubdomainCreateDataSourceModel, error) {
+	params := map[string]string{
+		"domain":     input.Subdomain,
+		"rootdomain": input.RootDomain,
+	}
+
+	// cPanel creates the document root in public_html when none is given
+	if input.Dir != "" {
+		params["dir"] = input.Dir
+	}
+
+	subdomain := SubdomainCreateDataSourceModel{}
+	err := c.executeUAPIOperation(cpanel.ModuleSubDomain, OperationAddSubdomain, params, &subdomain)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &subdomain, nil
+}
+
+func (c *Client) GetSubdomains() (*SubdomainDataSourceModel, error) {
+	subdomains := SubdomainDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModuleSubDomain, OperationListSubdomains, map[string]string{}, &subdomains)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &subdomains, nil
+}
+
+// GetSubdomain returns the subdomain of the root domain, or nil if it does not exist.
+func (c *Client) GetSubdomain(subdomain, rootDomain string) (*SubdomainDataSourceDataModel, error) {
+	subdomains, err := c.GetSubdomains()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, s := range subdomains.CpanelResult.Data {
+		if strings.EqualFold(s.Subdomain, subdomain) && strings.EqualFold(s.RootDomain, rootDomain) {
+			return &subdomains.CpanelResult.Data[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UpdateDocumentRoot changes the document root of a subdomain, or of the subdomain
+// backing an addon domain.
+func (c *Client) UpdateDocumentRoot(input DocumentRootUpdateModel) (*ResultDataSourceModel, error) {
+	result := ResultDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModuleSubDomain, OperationChangeDocumentRoot, map[string]string{
+		"subdomain":  input.Subdomain,
+		"rootdomain": input.RootDomain,
+		"dir":        input.Dir,
+	}, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) DeleteSubdomain(input SubdomainDeleteModel) (*ResultDataSourceModel, error) {
+	result := ResultDataSourceModel{}
+	err := c.executeAPI2Operation(cpanel.ModuleSubDomain, OperationDeleteSubdomain, map[string]string{
+		"domain": input.Domain,
+	}, &result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type SubdomainDataSourceModel struct {
+	CpanelResult SubdomainCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+type SubdomainCpanelResultModel struct {
+	cpanel.API2DataSourceCpanelResultModel
+	Data []SubdomainDataSourceDataModel `tfsdk:"data"`
+}
+
+// SubdomainDataSourceDataModel is a subdomain, Domain being its fully qualified name.
+// Dir is the absolute path of its document root, and BaseDir the path relative to
+// the home directory.
+type SubdomainDataSourceDataModel struct {
+	Domain     string `tfsdk:"domain"`
+	Subdomain  string `tfsdk:"subdomain"`
+	RootDomain string `tfsdk:"rootdomain"`
+	Dir        string `tfsdk:"dir"`
+	BaseDir    string `tfsdk:"basedir"`
+}
+
+type SubdomainCreateModel struct {
+	Subdomain  string `tfsdk:"subdomain"`
+	RootDomain string `tfsdk:"rootdomain"`
+	Dir        string `tfsdk:"dir"`
+}
+
+type SubdomainCreateDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+}
+
+type DocumentRootUpdateModel struct {
+	Subdomain  string `tfsdk:"subdomain"`
+	RootDomain string `tfsdk:"rootdomain"`
+	Dir        string `tfsdk:"dir"`
+}
+
+type SubdomainDeleteModel struct {
+	Domain string `tfsdk:"domain"`
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	// OperationAddSubdomain is a UAPI function, the other ones are API2 functions.
+	OperationAddSubdomain       = cpanel.WriteOperation("addsubdomain")
+	OperationListSubdomains     = cpanel.ReadOperation("listsubdomains")
+	OperationChangeDocumentRoot = cpanel.WriteOperation("changedocroot")
+	OperationDeleteSubdomain    = cpanel.WriteOperation("delsubdomain")
+)
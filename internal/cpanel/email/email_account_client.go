@@ -0,0 +1,81 @@
+package email
+
+import "strconv"
+
+func (c *Client) CreateAccount(input AccountCreateModel) (*AccountCreateDataSourceModel, error) {
+	sendWelcomeEmail := "0"
+	if input.SendWelcomeEmail {
+		sendWelcomeEmail = "1"
+	}
+
+	emailAccount := AccountCreateDataSourceModel{}
+	err := c.executeOperation(OperationAddPop, map[string]string{
+		"email":              input.Email,
+		"domain":             input.Domain,
+		"password":           input.Password,
+		"quota":              strconv.FormatInt(input.QuotaMB, 10),
+		"send_welcome_email": sendWelcomeEmail,
+	}, &emailAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailAccount, nil
+}
+
+func (c *Client) DeleteAccount(input AccountDeleteModel) (*AccountDataSourceModel, error) {
+	emailAccount := AccountDataSourceModel{}
+	err := c.executeOperation(OperationDeletePop, map[string]string{
+		"email":  input.Email,
+		"domain": input.Domain,
+	}, &emailAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailAccount, nil
+}
+
+// GetAccounts lists the email accounts of the domain, with their disk usage.
+func (c *Client) GetAccounts(domain string) (*AccountDataSourceModel, error) {
+	emailAccount := AccountDataSourceModel{}
+	err := c.executeOperation(OperationListPopsWithDisk, map[string]string{"domain": domain}, &emailAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailAccount, nil
+}
+
+func (c *Client) SetPassword(input AccountSetPasswordModel) (*AccountDataSourceModel, error) {
+	emailAccount := AccountDataSourceModel{}
+	err := c.executeOperation(OperationPasswdPop, map[string]string{
+		"email":    input.Email,
+		"domain":   input.Domain,
+		"password": input.Password,
+	}, &emailAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailAccount, nil
+}
+
+func (c *Client) SetQuota(input AccountSetQuotaModel) (*AccountDataSourceModel, error) {
+	emailAccount := AccountDataSourceModel{}
+	err := c.executeOperation(OperationEditPopQuota, map[string]string{
+		"email":  input.Email,
+		"domain": input.Domain,
+		"quota":  strconv.FormatInt(input.QuotaMB, 10),
+	}, &emailAccount)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailAccount, nil
+}
@@ -0,0 +1,46 @@
+package email
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type AccountDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []AccountDataSourceDataModel `tfsdk:"data"`
+}
+
+type AccountDataSourceDataModel struct {
+	Email     string       `tfsdk:"email"`
+	Login     string       `tfsdk:"login"`
+	User      string       `tfsdk:"user"`
+	Domain    string       `tfsdk:"domain"`
+	DiskQuota cpanel.Quota `tfsdk:"diskquota"`
+}
+
+type AccountCreateDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data string `tfsdk:"data"`
+}
+
+type AccountCreateModel struct {
+	Email            string `tfsdk:"email"`
+	Domain           string `tfsdk:"domain"`
+	Password         string `tfsdk:"password"`
+	QuotaMB          int64  `tfsdk:"quota"`
+	SendWelcomeEmail bool   `tfsdk:"send_welcome_email"`
+}
+
+type AccountSetPasswordModel struct {
+	Email    string `tfsdk:"email"`
+	Domain   string `tfsdk:"domain"`
+	Password string `tfsdk:"password"`
+}
+
+type AccountSetQuotaModel struct {
+	Email   string `tfsdk:"email"`
+	Domain  string `tfsdk:"domain"`
+	QuotaMB int64  `tfsdk:"quota"`
+}
+
+type AccountDeleteModel struct {
+	Email  string `tfsdk:"email"`
+	Domain string `tfsdk:"domain"`
+}
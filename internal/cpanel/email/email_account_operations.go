@@ -0,0 +1,11 @@
+package email
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationAddPop           = cpanel.WriteOperation("add_pop")
+	OperationDeletePop        = cpanel.WriteOperation("delete_pop")
+	OperationEditPopQuota     = cpanel.WriteOperation("edit_pop_quota")
+	OperationListPopsWithDisk = cpanel.ReadOperation("list_pops_with_disk")
+	OperationPasswdPop        = cpanel.WriteOperation("passwd_pop")
+)
@@ -0,0 +1,37 @@
+package email
+
+func (c *Client) CreateDomainForwarder(input DomainForwarderCreateModel) (*DomainForwarderDataSourceModel, error) {
+	emailDomainForwarder := DomainForwarderDataSourceModel{}
+	err := c.executeOperation(OperationAddDomainForwarder, map[string]string{
+		"domain":     input.Domain,
+		"destdomain": input.DestDomain,
+	}, &emailDomainForwarder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailDomainForwarder, nil
+}
+
+func (c *Client) DeleteDomainForwarder(input DomainForwarderDeleteModel) (*DomainForwarderDataSourceModel, error) {
+	emailDomainForwarder := DomainForwarderDataSourceModel{}
+	err := c.executeOperation(OperationDeleteDomainForwarder, map[string]string{"domain": input.Domain}, &emailDomainForwarder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailDomainForwarder, nil
+}
+
+func (c *Client) GetDomainForwarders() (*DomainForwarderDataSourceModel, error) {
+	emailDomainForwarder := DomainForwarderDataSourceModel{}
+	err := c.executeOperation(OperationListDomainForwarders, map[string]string{}, &emailDomainForwarder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailDomainForwarder, nil
+}
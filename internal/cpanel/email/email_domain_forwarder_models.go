@@ -0,0 +1,23 @@
+package email
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type DomainForwarderDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []DomainForwarderDataSourceDataModel `tfsdk:"data"`
+}
+
+type DomainForwarderDataSourceDataModel struct {
+	// Dest is the forwarded domain, and Forward the domain receiving its messages.
+	Dest    string `tfsdk:"dest"`
+	Forward string `tfsdk:"forward"`
+}
+
+type DomainForwarderCreateModel struct {
+	Domain     string `tfsdk:"domain"`
+	DestDomain string `tfsdk:"destdomain"`
+}
+
+type DomainForwarderDeleteModel struct {
+	Domain string `tfsdk:"domain"`
+}
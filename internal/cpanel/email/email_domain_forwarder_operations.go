@@ -0,0 +1,9 @@
+package email
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationAddDomainForwarder    = cpanel.WriteOperation("add_domain_forwarder")
+	OperationDeleteDomainForwarder = cpanel.WriteOperation("delete_domain_forwarder")
+	OperationListDomainForwarders  = cpanel.ReadOperation("list_domain_forwarders")
+)
@@ -0,0 +1,53 @@
+package email
+
+func (c *Client) CreateForwarder(input ForwarderCreateModel) (*ForwarderDataSourceModel, error) {
+	params := map[string]string{
+		"email":  input.Email + "@" + input.Domain,
+		"domain": input.Domain,
+		"fwdopt": input.Action,
+	}
+
+	switch input.Action {
+	case ForwarderActionForward:
+		params["fwdemail"] = input.Destination
+	case ForwarderActionPipe:
+		params["pipefwd"] = input.Destination
+	case ForwarderActionFail:
+		params["failmsgs"] = input.FailMessage
+	}
+
+	emailForwarder := ForwarderDataSourceModel{}
+	err := c.executeOperation(OperationAddForwarder, params, &emailForwarder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailForwarder, nil
+}
+
+func (c *Client) DeleteForwarder(input ForwarderDeleteModel) (*ForwarderDataSourceModel, error) {
+	emailForwarder := ForwarderDataSourceModel{}
+	err := c.executeOperation(OperationDeleteForwarder, map[string]string{
+		"address":   input.Address,
+		"forwarder": input.Forwarder,
+	}, &emailForwarder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailForwarder, nil
+}
+
+// GetForwarders lists the forwarders of the domain.
+func (c *Client) GetForwarders(domain string) (*ForwarderDataSourceModel, error) {
+	emailForwarder := ForwarderDataSourceModel{}
+	err := c.executeOperation(OperationListForwarders, map[string]string{"domain": domain}, &emailForwarder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &emailForwarder, nil
+}
@@ -0,0 +1,42 @@
+package email
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+// Forwarder actions, as accepted by the fwdopt parameter of add_forwarder.
+const (
+	ForwarderActionForward   = "fwd"
+	ForwarderActionPipe      = "pipe"
+	ForwarderActionFail      = "fail"
+	ForwarderActionBlackhole = "blackhole"
+)
+
+// Prefixes of the forward field of list_forwarders for the fail, blackhole and pipe actions.
+const (
+	ForwardFailPrefix = ":fail:"
+	ForwardBlackhole  = ":blackhole:"
+	ForwardPipePrefix = "|"
+)
+
+type ForwarderDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []ForwarderDataSourceDataModel `tfsdk:"data"`
+}
+
+type ForwarderDataSourceDataModel struct {
+	// Dest is the forwarded address, and Forward where its messages go.
+	Dest    string `tfsdk:"dest"`
+	Forward string `tfsdk:"forward"`
+}
+
+type ForwarderCreateModel struct {
+	Email       string `tfsdk:"email"`
+	Domain      string `tfsdk:"domain"`
+	Action      string `tfsdk:"action"`
+	Destination string `tfsdk:"destination"`
+	FailMessage string `tfsdk:"fail_message"`
+}
+
+type ForwarderDeleteModel struct {
+	Address   string `tfsdk:"address"`
+	Forwarder string `tfsdk:"forwarder"`
+}
@@ -0,0 +1,9 @@
+package email
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationAddForwarder    = cpanel.WriteOperation("add_forwarder")
+	OperationDeleteForwarder = cpanel.WriteOperation("delete_forwarder")
+	OperationListForwarders  = cpanel.ReadOperation("list_forwarders")
+)
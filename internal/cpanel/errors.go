@@ -0,0 +1,268 @@
+package cpanel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	// ErrNotFound is matched by errors reporting that the requested object does not exist.
+	ErrNotFound = errors.New("cpanel: not found")
+	// ErrAlreadyExists is matched by errors reporting that the object to create already exists.
+	ErrAlreadyExists = errors.New("cpanel: already exists")
+	// ErrAuth is matched by errors caused by invalid credentials or missing privileges.
+	ErrAuth = errors.New("cpanel: authentication failed")
+)
+
+var (
+	notFoundMessages = []string{
+		"does not exist",
+		"doesn't exist",
+		"not found",
+		"no such",
+		"could not find",
+		"unable to find",
+		"invalid line",
+	}
+	alreadyExistsMessages = []string{
+		"already exists",
+		"already exist",
+		"is already in use",
+		"already has",
+	}
+	authMessages = []string{
+		"access denied",
+		"permission denied",
+		"invalid token",
+		"not authorized",
+	}
+)
+
+// StatusError is returned when cPanel answers with a non-200 HTTP status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+func (e *StatusError) Is(target error) bool {
+	return target == ErrAuth && (e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden)
+}
+
+// UAPIError is returned when a UAPI function answers with a status other than 1.
+type UAPIError struct {
+	Module     string
+	Function   string
+	Errors     []string
+	Messages   []string
+	Warnings   []string
+	HTTPStatus int
+}
+
+func (e *UAPIError) Error() string {
+	return fmt.Sprintf("%s::%s failed: [%s]", e.Module, e.Function, strings.Join(e.Errors, ", "))
+}
+
+func (e *UAPIError) Is(target error) bool {
+	return matchesSentinel(target, e.HTTPStatus, e.Errors)
+}
+
+// API2Error is returned when an API2 function reports a failure, either globally
+// in the cpanelresult or in the status of its returned data.
+type API2Error struct {
+	Module     string
+	Function   string
+	Messages   []string
+	Warnings   []string
+	HTTPStatus int
+}
+
+func (e *API2Error) Error() string {
+	return fmt.Sprintf("%s::%s failed: [%s]", e.Module, e.Function, strings.Join(e.Messages, ", "))
+}
+
+func (e *API2Error) Is(target error) bool {
+	return matchesSentinel(target, e.HTTPStatus, e.Messages)
+}
+
+// matchesSentinel classifies a cPanel failure from its HTTP status and messages,
+// since cPanel does not return machine-readable error codes.
+func matchesSentinel(target error, httpStatus int, messages []string) bool {
+	var patterns []string
+
+	switch target {
+	case ErrNotFound:
+		patterns = notFoundMessages
+	case ErrAlreadyExists:
+		patterns = alreadyExistsMessages
+	case ErrAuth:
+		if httpStatus == http.StatusUnauthorized || httpStatus == http.StatusForbidden {
+			return true
+		}
+		patterns = authMessages
+	default:
+		return false
+	}
+
+	for _, message := range messages {
+		message = strings.ToLower(message)
+		for _, pattern := range patterns {
+			if strings.Contains(message, pattern) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// uapiResult is the envelope shared by every UAPI response.
+type uapiResult struct {
+	Status   int64    `json:"status"`
+	Errors   []string `json:"errors"`
+	Messages []string `json:"messages"`
+	Warnings []string `json:"warnings"`
+}
+
+// checkUAPIResult returns a *UAPIError when body is a failed UAPI response.
+func checkUAPIResult(module, function string, httpStatus int, body []byte) error {
+	var result uapiResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+
+	if result.Status == 1 {
+		return nil
+	}
+
+	return &UAPIError{
+		Module:     module,
+		Function:   function,
+		Errors:     result.Errors,
+		Messages:   result.Messages,
+		Warnings:   result.Warnings,
+		HTTPStatus: httpStatus,
+	}
+}
+
+// api2Result is the envelope shared by every API2 response.
+type api2Result struct {
+	CpanelResult struct {
+		Error    string   `json:"error"`
+		Warnings []string `json:"warnings"`
+		Event    struct {
+			Result json.RawMessage `json:"result"`
+		} `json:"event"`
+		Data json.RawMessage `json:"data"`
+	} `json:"cpanelresult"`
+}
+
+// checkAPI2Result returns an *API2Error when body is a failed API2 response.
+func checkAPI2Result(module, function string, httpStatus int, body []byte) error {
+	var result api2Result
+	if err := json.Unmarshal(body, &result); err != nil {
+		return err
+	}
+
+	var messages []string
+
+	if result.CpanelResult.Error != "" {
+		messages = append(messages, result.CpanelResult.Error)
+	} else if isFalsy(result.CpanelResult.Event.Result) {
+		messages = append(messages, "the API2 event returned no result")
+	}
+
+	// Functions such as Cron::add_line report their own status in the data, and
+	// functions such as Park::park their own result along with its reason
+	var data []map[string]json.RawMessage
+	if err := json.Unmarshal(result.CpanelResult.Data, &data); err == nil {
+		for _, item := range data {
+			switch {
+			case isFalsy(item["status"]):
+				var statusMessage string
+				_ = json.Unmarshal(item["statusmsg"], &statusMessage)
+				if statusMessage == "" {
+					statusMessage = "the API2 function returned a failed status"
+				}
+				messages = append(messages, statusMessage)
+			case isFalsy(item["result"]):
+				var reason string
+				_ = json.Unmarshal(item["reason"], &reason)
+				if reason == "" {
+					reason = "the API2 function returned a failed result"
+				}
+				messages = append(messages, reason)
+			}
+		}
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return &API2Error{
+		Module:     module,
+		Function:   function,
+		Messages:   messages,
+		Warnings:   result.CpanelResult.Warnings,
+		HTTPStatus: httpStatus,
+	}
+}
+
+// isFalsy reports whether a present JSON status value is 0, "0", false or "".
+// A missing value is not considered falsy.
+func isFalsy(value json.RawMessage) bool {
+	if len(value) == 0 || string(value) == "null" {
+		return false
+	}
+
+	var boolean bool
+	if err := json.Unmarshal(value, &boolean); err == nil {
+		return !boolean
+	}
+
+	var text string
+	if err := json.Unmarshal(value, &text); err == nil {
+		number, err := strconv.ParseFloat(text, 64)
+		return text == "" || (err == nil && number == 0)
+	}
+
+	var number float64
+	if err := json.Unmarshal(value, &number); err == nil {
+		return number == 0
+	}
+
+	return false
+}
+
+// IsTransient reports whether err is worth retrying: rate limiting, gateway
+// errors, connection resets and timeouts.
+func IsTransient(err error) bool {
+	var statusError *StatusError
+	if errors.As(err, &statusError) {
+		switch statusError.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	var netError net.Error
+	if errors.As(err, &netError) && netError.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,101 @@
+package ftp
+
+import (
+	"strconv"
+	"strings"
+)
+
+func (c *Client) CreateAccount(input AccountCreateModel) (*AccountUpdateDataSourceModel, error) {
+	params := map[string]string{
+		"user":   input.User,
+		"domain": input.Domain,
+		"pass":   input.Password,
+		"quota":  strconv.FormatInt(input.QuotaMB, 10),
+	}
+	if input.HomeDir != "" {
+		params["homedir"] = input.HomeDir
+	}
+
+	account := AccountUpdateDataSourceModel{}
+	err := c.executeOperation(OperationAddFtp, params, &account)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// GetAccounts lists the FTP accounts, with their disk usage.
+func (c *Client) GetAccounts() (*AccountDataSourceModel, error) {
+	accounts := AccountDataSourceModel{}
+	err := c.executeOperation(OperationListFtpWithDisk, map[string]string{}, &accounts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &accounts, nil
+}
+
+// GetAccount returns the FTP account logging in as user@domain, or nil if it does not exist.
+func (c *Client) GetAccount(user, domain string) (*AccountDataSourceDataModel, error) {
+	accounts, err := c.GetAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, account := range accounts.Data {
+		if strings.EqualFold(account.Login, user+"@"+domain) {
+			return &accounts.Data[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) SetPassword(input AccountSetPasswordModel) (*AccountUpdateDataSourceModel, error) {
+	account := AccountUpdateDataSourceModel{}
+	err := c.executeOperation(OperationPasswd, map[string]string{
+		"user":   input.User,
+		"domain": input.Domain,
+		"pass":   input.Password,
+	}, &account)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (c *Client) SetQuota(input AccountSetQuotaModel) (*AccountUpdateDataSourceModel, error) {
+	account := AccountUpdateDataSourceModel{}
+	err := c.executeOperation(OperationSetQuota, map[string]string{
+		"user":   input.User,
+		"domain": input.Domain,
+		"quota":  strconv.FormatInt(input.QuotaMB, 10),
+	}, &account)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+// DeleteAccount deletes the FTP account, keeping the files of its home directory.
+func (c *Client) DeleteAccount(input AccountDeleteModel) (*AccountUpdateDataSourceModel, error) {
+	account := AccountUpdateDataSourceModel{}
+	err := c.executeOperation(OperationDeleteFtp, map[string]string{
+		"user":    input.User,
+		"domain":  input.Domain,
+		"destroy": "0",
+	}, &account)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
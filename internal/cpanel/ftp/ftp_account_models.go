@@ -0,0 +1,48 @@
+package ftp
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type AccountDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []AccountDataSourceDataModel `tfsdk:"data"`
+}
+
+// AccountDataSourceDataModel is an FTP account, Login being the user@domain name used to
+// log in and RelDir the home directory relative to the home directory of the cPanel account.
+type AccountDataSourceDataModel struct {
+	Login     string       `tfsdk:"login"`
+	User      string       `tfsdk:"user"`
+	AcctType  string       `tfsdk:"accttype"`
+	Dir       string       `tfsdk:"dir"`
+	RelDir    string       `tfsdk:"reldir"`
+	DiskQuota cpanel.Quota `tfsdk:"diskquota"`
+}
+
+type AccountCreateModel struct {
+	User     string `tfsdk:"user"`
+	Domain   string `tfsdk:"domain"`
+	Password string `tfsdk:"pass"`
+	HomeDir  string `tfsdk:"homedir"`
+	QuotaMB  int64  `tfsdk:"quota"`
+}
+
+type AccountSetPasswordModel struct {
+	User     string `tfsdk:"user"`
+	Domain   string `tfsdk:"domain"`
+	Password string `tfsdk:"pass"`
+}
+
+type AccountSetQuotaModel struct {
+	User    string `tfsdk:"user"`
+	Domain  string `tfsdk:"domain"`
+	QuotaMB int64  `tfsdk:"quota"`
+}
+
+type AccountDeleteModel struct {
+	User   string `tfsdk:"user"`
+	Domain string `tfsdk:"domain"`
+}
+
+type AccountUpdateDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+}
@@ -0,0 +1,11 @@
+package ftp
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationAddFtp          = cpanel.WriteOperation("add_ftp")
+	OperationDeleteFtp       = cpanel.WriteOperation("delete_ftp")
+	OperationListFtpWithDisk = cpanel.ReadOperation("list_ftp_with_disk")
+	OperationPasswd          = cpanel.WriteOperation("passwd")
+	OperationSetQuota        = cpanel.WriteOperation("set_quota")
+)
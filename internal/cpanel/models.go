@@ -1,5 +1,11 @@
 package cpanel
 
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
 type API2DataSourceCpanelResultModel struct {
 	ApiVersion int                 `tfsdk:"apiversion"`
 	Func       string              `tfsdk:"func"`
@@ -22,3 +28,35 @@ type UAPIDataSourceModel struct {
 type UAPIDataSourceMetadata struct {
 	Transformed int64 `tfsdk:"transformed"`
 }
+
+// Quota is a size in megabytes, 0 meaning unlimited. cPanel returns it either as
+// a number, a numeric string, or "unlimited".
+type Quota int64
+
+func (q *Quota) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case float64:
+		*q = Quota(v)
+	case string:
+		if v == "" || strings.EqualFold(v, "unlimited") || strings.EqualFold(v, "none") {
+			*q = 0
+			return nil
+		}
+
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return err
+		}
+		*q = Quota(f)
+	default:
+		*q = 0
+	}
+
+	return nil
+}
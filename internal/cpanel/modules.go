@@ -1,6 +1,16 @@
 package cpanel
 
 const (
-	ModuleCron       = "Cron"
-	ModulePostgresql = "Postgresql"
+	ModuleAddonDomain = "AddonDomain"
+	ModuleCron        = "Cron"
+	ModuleDNS         = "DNS"
+	ModuleDomainInfo  = "DomainInfo"
+	ModuleEmail       = "Email"
+	ModuleFtp         = "Ftp"
+	ModuleMysql       = "Mysql"
+	ModulePark        = "Park"
+	ModulePostgresql  = "Postgresql"
+	ModuleSSH         = "SSH"
+	ModuleSSL         = "SSL"
+	ModuleSubDomain   = "SubDomain"
 )
@@ -0,0 +1,17 @@
+package mysql
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type Client struct {
+	*cpanel.Client
+}
+
+func NewClient(c *cpanel.Client) *Client {
+	return &Client{
+		Client: c,
+	}
+}
+
+func (c *Client) executeOperation(operation cpanel.Operation, params map[string]string, inputModel interface{}) error {
+	return c.Client.ExecuteUAPIOperation(cpanel.ModuleMysql, operation, params, inputModel)
+}
@@ -0,0 +1,48 @@
+package mysql
+
+func (c *Client) CreateDatabase(input DatabaseCreateModel) (*DatabaseDataSourceModel, error) {
+	mySQLDatabase := DatabaseDataSourceModel{}
+	err := c.executeOperation(OperationCreateDatabase, map[string]string{"name": input.Name}, &mySQLDatabase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLDatabase, nil
+}
+
+func (c *Client) DeleteDatabase(input DatabaseDeleteModel) (*DatabaseDataSourceModel, error) {
+	mySQLDatabase := DatabaseDataSourceModel{}
+	err := c.executeOperation(OperationDeleteDatabase, map[string]string{"name": input.Name}, &mySQLDatabase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLDatabase, nil
+}
+
+func (c *Client) GetDatabases() (*DatabaseDataSourceModel, error) {
+	mySQLDatabase := DatabaseDataSourceModel{}
+	err := c.executeOperation(OperationListDatabases, map[string]string{}, &mySQLDatabase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLDatabase, nil
+}
+
+func (c *Client) UpdateDatabase(input DatabaseUpdateModel) (*DatabaseDataSourceModel, error) {
+	mySQLDatabase := DatabaseDataSourceModel{}
+	err := c.executeOperation(OperationRenameDatabase, map[string]string{
+		"oldname": input.OldName,
+		"newname": input.NewName,
+	}, &mySQLDatabase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLDatabase, nil
+}
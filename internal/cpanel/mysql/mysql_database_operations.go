@@ -0,0 +1,10 @@
+package mysql
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationCreateDatabase = cpanel.WriteOperation("create_database")
+	OperationListDatabases  = cpanel.ReadOperation("list_databases")
+	OperationRenameDatabase = cpanel.WriteOperation("rename_database")
+	OperationDeleteDatabase = cpanel.WriteOperation("delete_database")
+)
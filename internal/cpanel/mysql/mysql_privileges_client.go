@@ -0,0 +1,47 @@
+package mysql
+
+import "strings"
+
+func (c *Client) GetPrivileges(input PrivilegesQueryModel) (*PrivilegesDataSourceModel, error) {
+	mySQLPrivileges := PrivilegesDataSourceModel{}
+	err := c.executeOperation(OperationGetPrivilegesOnDatabase, map[string]string{
+		"database": input.Database,
+		"user":     input.User,
+	}, &mySQLPrivileges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLPrivileges, nil
+}
+
+// SetPrivileges replaces the privileges of the user on the database.
+func (c *Client) SetPrivileges(input PrivilegesSetModel) (*PrivilegesDataSourceModel, error) {
+	mySQLPrivileges := PrivilegesDataSourceModel{}
+	err := c.executeOperation(OperationSetPrivilegesOnDatabase, map[string]string{
+		"database":   input.Database,
+		"privileges": strings.Join(input.Privileges, ","),
+		"user":       input.User,
+	}, &mySQLPrivileges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLPrivileges, nil
+}
+
+func (c *Client) RevokePrivileges(input PrivilegesRevokeModel) (*PrivilegesDataSourceModel, error) {
+	mySQLPrivileges := PrivilegesDataSourceModel{}
+	err := c.executeOperation(OperationRevokeAccessToDatabase, map[string]string{
+		"database": input.Database,
+		"user":     input.User,
+	}, &mySQLPrivileges)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLPrivileges, nil
+}
@@ -0,0 +1,49 @@
+package mysql
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+// AllPrivileges grants every privilege on a database.
+const AllPrivileges = "ALL PRIVILEGES"
+
+// Privileges lists the privileges that can be granted on a database, besides AllPrivileges.
+var Privileges = []string{
+	"ALTER",
+	"ALTER ROUTINE",
+	"CREATE",
+	"CREATE ROUTINE",
+	"CREATE TEMPORARY TABLES",
+	"CREATE VIEW",
+	"DELETE",
+	"DROP",
+	"EVENT",
+	"EXECUTE",
+	"INDEX",
+	"INSERT",
+	"LOCK TABLES",
+	"REFERENCES",
+	"SELECT",
+	"SHOW VIEW",
+	"TRIGGER",
+	"UPDATE",
+}
+
+type PrivilegesDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []string `tfsdk:"data"`
+}
+
+type PrivilegesQueryModel struct {
+	Database string `tfsdk:"database"`
+	User     string `tfsdk:"user"`
+}
+
+type PrivilegesSetModel struct {
+	Database   string   `tfsdk:"database"`
+	Privileges []string `tfsdk:"privileges"`
+	User       string   `tfsdk:"user"`
+}
+
+type PrivilegesRevokeModel struct {
+	Database string `tfsdk:"database"`
+	User     string `tfsdk:"user"`
+}
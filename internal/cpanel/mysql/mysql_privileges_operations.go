@@ -0,0 +1,9 @@
+package mysql
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationGetPrivilegesOnDatabase = cpanel.ReadOperation("get_privileges_on_database")
+	OperationRevokeAccessToDatabase  = cpanel.WriteOperation("revoke_access_to_database")
+	OperationSetPrivilegesOnDatabase = cpanel.WriteOperation("set_privileges_on_database")
+)
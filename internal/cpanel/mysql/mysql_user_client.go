@@ -0,0 +1,65 @@
+package mysql
+
+func (c *Client) CreateUser(input UserCreateModel) (*UserDataSourceModel, error) {
+	mySQLUser := UserDataSourceModel{}
+	err := c.executeOperation(OperationCreateUser, map[string]string{
+		"name":     input.Name,
+		"password": input.Password,
+	}, &mySQLUser)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLUser, nil
+}
+
+func (c *Client) DeleteUser(input UserDeleteModel) (*UserDataSourceModel, error) {
+	mySQLUser := UserDataSourceModel{}
+	err := c.executeOperation(OperationDeleteUser, map[string]string{"name": input.Name}, &mySQLUser)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLUser, nil
+}
+
+func (c *Client) GetUsers() (*UserDataSourceModel, error) {
+	mySQLUser := UserDataSourceModel{}
+	err := c.executeOperation(OperationListUsers, map[string]string{}, &mySQLUser)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLUser, nil
+}
+
+func (c *Client) RenameUser(input UserRenameModel) (*UserDataSourceModel, error) {
+	mySQLUser := UserDataSourceModel{}
+	err := c.executeOperation(OperationRenameUser, map[string]string{
+		"newname": input.NewName,
+		"oldname": input.OldName,
+	}, &mySQLUser)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLUser, nil
+}
+
+func (c *Client) SetPassword(input UserSetPasswordModel) (*UserDataSourceModel, error) {
+	mySQLUser := UserDataSourceModel{}
+	err := c.executeOperation(OperationSetPassword, map[string]string{
+		"user":     input.User,
+		"password": input.Password,
+	}, &mySQLUser)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &mySQLUser, nil
+}
@@ -0,0 +1,32 @@
+package mysql
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type UserDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []UserDataSourceDataModel `tfsdk:"data"`
+}
+
+type UserDataSourceDataModel struct {
+	User      string   `tfsdk:"user"`
+	Databases []string `tfsdk:"databases"`
+}
+
+type UserCreateModel struct {
+	Name     string `tfsdk:"name"`
+	Password string `tfsdk:"password"`
+}
+
+type UserDeleteModel struct {
+	Name string `tfsdk:"name"`
+}
+
+type UserRenameModel struct {
+	NewName string `tfsdk:"new_name"`
+	OldName string `tfsdk:"old_name"`
+}
+
+type UserSetPasswordModel struct {
+	Password string `tfsdk:"password"`
+	User     string `tfsdk:"user"`
+}
@@ -0,0 +1,11 @@
+package mysql
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationCreateUser  = cpanel.WriteOperation("create_user")
+	OperationDeleteUser  = cpanel.WriteOperation("delete_user")
+	OperationListUsers   = cpanel.ReadOperation("list_users")
+	OperationRenameUser  = cpanel.WriteOperation("rename_user")
+	OperationSetPassword = cpanel.WriteOperation("set_password")
+)
@@ -0,0 +1,21 @@
+package cpanel
+
+import "net/http"
+
+// Operation is a cPanel API function along with the HTTP method it is called
+// with. Mutating functions are sent as POST so that their parameters, such as
+// passwords, do not end up in access logs.
+type Operation struct {
+	Function string
+	Method   string
+}
+
+// ReadOperation returns a read-only operation, sent as GET.
+func ReadOperation(function string) Operation {
+	return Operation{Function: function, Method: http.MethodGet}
+}
+
+// WriteOperation returns a mutating operation, sent as a form-encoded POST.
+func WriteOperation(function string) Operation {
+	return Operation{Function: function, Method: http.MethodPost}
+}
@@ -0,0 +1,87 @@
+package cpanel
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const DefaultRequestTimeout = 10 * time.Second
+
+// ClientOption customizes a Client created with NewClient.
+type ClientOption func(*Client) error
+
+// TLSOptions configures how the Client verifies cPanel and authenticates itself.
+type TLSOptions struct {
+	// CACertPEM holds additional PEM encoded certificate authorities trusted
+	// on top of the system pool.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM hold a PEM encoded client certificate and its key.
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
+}
+
+// WithRetry sets how many times transient errors are retried, and the maximum wait between two attempts.
+func WithRetry(maxRetries int, retryMaxWait time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.MaxRetries = maxRetries
+		c.RetryMaxWait = retryMaxWait
+		return nil
+	}
+}
+
+// WithRequestTimeout sets the time limit of a single request attempt.
+func WithRequestTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.HTTPClient.Timeout = timeout
+		return nil
+	}
+}
+
+// WithTLS configures the transport of the Client with the given TLS options.
+func WithTLS(options TLSOptions) ClientOption {
+	return func(c *Client) error {
+		tlsConfig := &tls.Config{
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: options.InsecureSkipVerify, //nolint:gosec // explicitly requested by the practitioner
+		}
+
+		if len(options.CACertPEM) > 0 {
+			rootCAs, err := x509.SystemCertPool()
+			if err != nil || rootCAs == nil {
+				rootCAs = x509.NewCertPool()
+			}
+
+			if !rootCAs.AppendCertsFromPEM(options.CACertPEM) {
+				return errors.New("no valid certificate found in the CA bundle")
+			}
+
+			tlsConfig.RootCAs = rootCAs
+		}
+
+		if len(options.ClientCertPEM) > 0 || len(options.ClientKeyPEM) > 0 {
+			certificate, err := tls.X509KeyPair(options.ClientCertPEM, options.ClientKeyPEM)
+			if err != nil {
+				return fmt.Errorf("invalid client certificate: %w", err)
+			}
+
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+		}
+
+		transport, ok := http.DefaultTransport.(*http.Transport)
+		if !ok {
+			return fmt.Errorf("unexpected default transport type: %T", http.DefaultTransport)
+		}
+
+		transport = transport.Clone()
+		transport.TLSClientConfig = tlsConfig
+		c.HTTPClient.Transport = transport
+
+		return nil
+	}
+}
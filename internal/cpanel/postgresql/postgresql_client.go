@@ -12,6 +12,6 @@ func NewClient(c *cpanel.Client) *Client {
 	}
 }
 
-func (c *Client) executeOperation(function string, queryParams map[string]string, inputModel interface{}) error {
-	return c.Client.ExecuteUAPIOperation(cpanel.ModulePostgresql, function, queryParams, inputModel)
+func (c *Client) executeOperation(operation cpanel.Operation, params map[string]string, inputModel interface{}) error {
+	return c.Client.ExecuteUAPIOperation(cpanel.ModulePostgresql, operation, params, inputModel)
 }
@@ -1,8 +1,10 @@
 package postgresql
 
-const (
-	OperationCreateDatabase = "create_database"
-	OperationListDatabases  = "list_databases"
-	OperationRenameDatabase = "rename_database"
-	OperationDeleteDatabase = "delete_database"
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationCreateDatabase = cpanel.WriteOperation("create_database")
+	OperationListDatabases  = cpanel.ReadOperation("list_databases")
+	OperationRenameDatabase = cpanel.WriteOperation("rename_database")
+	OperationDeleteDatabase = cpanel.WriteOperation("delete_database")
 )
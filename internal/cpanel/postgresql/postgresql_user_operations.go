@@ -1,11 +1,13 @@
 package postgresql
 
-const (
-	OperationCreateUser          = "create_user"
-	OperationDeleteUser          = "delete_user"
-	OperationGrantAllPrivileges  = "grant_all_privileges"
-	OperationListUsers           = "list_users"
-	OperationRenameUser          = "rename_user"
-	OperationRevokeAllPrivileges = "revoke_all_privileges"
-	OperationSetPassword         = "set_password"
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationCreateUser          = cpanel.WriteOperation("create_user")
+	OperationDeleteUser          = cpanel.WriteOperation("delete_user")
+	OperationGrantAllPrivileges  = cpanel.WriteOperation("grant_all_privileges")
+	OperationListUsers           = cpanel.ReadOperation("list_users")
+	OperationRenameUser          = cpanel.WriteOperation("rename_user")
+	OperationRevokeAllPrivileges = cpanel.WriteOperation("revoke_all_privileges")
+	OperationSetPassword         = cpanel.WriteOperation("set_password")
 )
@@ -0,0 +1,30 @@
+package cpanel
+
+import (
+	"strings"
+	"sync"
+)
+
+// requestQueues holds one queue per cPanel host and user. cPanel rejects
+// concurrent requests on the same account, so every Client talking to the
+// same account shares a queue, even across provider aliases.
+var (
+	requestQueuesMutex sync.Mutex
+	requestQueues      = map[string]*sync.Mutex{}
+)
+
+// requestQueue returns the queue serializing requests for the given host and user.
+func requestQueue(host, username string) *sync.Mutex {
+	key := strings.TrimRight(strings.ToLower(host), "/") + "|" + username
+
+	requestQueuesMutex.Lock()
+	defer requestQueuesMutex.Unlock()
+
+	queue, ok := requestQueues[key]
+	if !ok {
+		queue = &sync.Mutex{}
+		requestQueues[key] = queue
+	}
+
+	return queue
+}
@@ -0,0 +1,72 @@
+package cpanel
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryMinWait = 1 * time.Second
+)
+
+// shouldRetry reports whether the failed request can be sent again. Mutating
+// requests are only retried when cPanel is known not to have processed them.
+func shouldRetry(req *http.Request, err error) bool {
+	if !IsTransient(err) {
+		return false
+	}
+
+	if req.Method == http.MethodGet {
+		return true
+	}
+
+	var statusError *StatusError
+	return errors.As(err, &statusError) &&
+		(statusError.StatusCode == http.StatusTooManyRequests || statusError.StatusCode == http.StatusServiceUnavailable)
+}
+
+// retryWait returns how long to wait before the given retry attempt, using
+// exponential backoff with jitter, capped at RetryMaxWait. A Retry-After
+// header sent by cPanel takes precedence.
+func (c *Client) retryWait(attempt int, err error) time.Duration {
+	var statusError *StatusError
+	if errors.As(err, &statusError) && statusError.RetryAfter > 0 {
+		return min(statusError.RetryAfter, c.RetryMaxWait)
+	}
+
+	wait := retryMinWait << attempt
+	if wait <= 0 || wait > c.RetryMaxWait {
+		wait = c.RetryMaxWait
+	}
+
+	// Pick a random wait between half and the full backoff
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// parseRetryAfter parses a Retry-After header expressed either in seconds or as an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+
+	return 0
+}
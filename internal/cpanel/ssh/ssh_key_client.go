@@ -0,0 +1,103 @@
+package ssh
+
+import "strings"
+
+func (c *Client) ImportKey(input KeyImportModel) (*KeyUpdateDataSourceModel, error) {
+	key := KeyUpdateDataSourceModel{}
+	err := c.executeOperation(OperationImportKey, map[string]string{
+		"name": input.Name,
+		"key":  input.Key,
+	}, &key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// AuthorizeKey adds the public key to authorized_keys, or removes it when Authorize is false.
+func (c *Client) AuthorizeKey(input KeyAuthorizeModel) (*KeyUpdateDataSourceModel, error) {
+	action := "deauthorize"
+	if input.Authorize {
+		action = "authorize"
+	}
+
+	key := KeyUpdateDataSourceModel{}
+	err := c.executeOperation(OperationAuthKey, map[string]string{
+		"key":    input.Name,
+		"action": action,
+	}, &key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// GetKeys lists the public keys, without their contents.
+func (c *Client) GetKeys() (*KeysDataSourceModel, error) {
+	keys := KeysDataSourceModel{}
+	err := c.executeOperation(OperationListKeys, map[string]string{"pub": "1"}, &keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &keys, nil
+}
+
+// GetKey returns the public key with its contents, or nil if it does not exist.
+func (c *Client) GetKey(name string) (*KeyDataSourceDataModel, error) {
+	keys, err := c.GetKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys.CpanelResult.Data {
+		if key.Name == name {
+			key.Key, err = c.FetchKey(name)
+			if err != nil {
+				return nil, err
+			}
+
+			return &key, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// FetchKey returns the contents of a public key.
+func (c *Client) FetchKey(name string) (string, error) {
+	keys := KeysDataSourceModel{}
+	err := c.executeOperation(OperationFetchKey, map[string]string{
+		"name": name,
+		"pub":  "1",
+	}, &keys)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(keys.CpanelResult.Data) == 0 {
+		return "", nil
+	}
+
+	return strings.TrimSpace(keys.CpanelResult.Data[0].Key), nil
+}
+
+func (c *Client) DeleteKey(input KeyDeleteModel) (*KeyUpdateDataSourceModel, error) {
+	key := KeyUpdateDataSourceModel{}
+	err := c.executeOperation(OperationDelKey, map[string]string{
+		"name": input.Name,
+		"pub":  "1",
+	}, &key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
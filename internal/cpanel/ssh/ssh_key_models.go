@@ -0,0 +1,80 @@
+package ssh
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+type KeysDataSourceModel struct {
+	CpanelResult KeysCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+type KeysCpanelResultModel struct {
+	cpanel.API2DataSourceCpanelResultModel
+	Data []KeyDataSourceDataModel `tfsdk:"data"`
+}
+
+// KeyDataSourceDataModel is a key stored in the .ssh directory, AuthStatus being
+// "authorized" when it is listed in authorized_keys. Key holds the contents of the key
+// when it is fetched.
+type KeyDataSourceDataModel struct {
+	Name       string `tfsdk:"name"`
+	File       string `tfsdk:"file"`
+	AuthStatus string `tfsdk:"authstatus"`
+	Key        string `tfsdk:"key"`
+}
+
+// Authorized reports whether the key is allowed to log in.
+func (k KeyDataSourceDataModel) Authorized() bool {
+	return k.AuthStatus == "authorized"
+}
+
+type KeyImportModel struct {
+	Name string `tfsdk:"name"`
+	Key  string `tfsdk:"key"`
+}
+
+type KeyAuthorizeModel struct {
+	Name      string `tfsdk:"key"`
+	Authorize bool   `tfsdk:"action"`
+}
+
+type KeyDeleteModel struct {
+	Name string `tfsdk:"name"`
+}
+
+// KeyUpdateDataSourceModel is returned by the functions changing keys, whose data is not used.
+type KeyUpdateDataSourceModel struct {
+	CpanelResult cpanel.API2DataSourceCpanelResultModel `tfsdk:"cpanelresult"`
+}
+
+// Fingerprint returns the SHA256 fingerprint of a public key in the authorized_keys
+// format, as printed by ssh-keygen -l.
+func Fingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", errors.New("the public key must hold its type and its base64 encoded data")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// SamePublicKey compares two public keys by their type and data, ignoring their comment.
+func SamePublicKey(a, b string) bool {
+	fieldsA, fieldsB := strings.Fields(a), strings.Fields(b)
+	if len(fieldsA) < 2 || len(fieldsB) < 2 {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+
+	return fieldsA[0] == fieldsB[0] && fieldsA[1] == fieldsB[1]
+}
@@ -0,0 +1,11 @@
+package ssh
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationImportKey = cpanel.WriteOperation("importkey")
+	OperationAuthKey   = cpanel.WriteOperation("authkey")
+	OperationListKeys  = cpanel.ReadOperation("listkeys")
+	OperationFetchKey  = cpanel.ReadOperation("fetchkey")
+	OperationDelKey    = cpanel.WriteOperation("delkey")
+)
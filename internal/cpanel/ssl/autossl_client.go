@@ -0,0 +1,69 @@
+package ssl
+
+import "strings"
+
+func (c *Client) GetAutoSSLExcludedDomains() (*AutoSSLExcludedDomainsDataSourceModel, error) {
+	domains := AutoSSLExcludedDomainsDataSourceModel{}
+	err := c.executeOperation(OperationGetAutoSSLExcludedDomains, map[string]string{}, &domains)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domains, nil
+}
+
+func (c *Client) AddAutoSSLExcludedDomains(input AutoSSLExcludedDomainsUpdateModel) (*AutoSSLExcludedDomainsUpdateDataSourceModel, error) {
+	domains := AutoSSLExcludedDomainsUpdateDataSourceModel{}
+	err := c.executeOperation(OperationAddAutoSSLExcludedDomains, map[string]string{"domains": strings.Join(input.Domains, ",")}, &domains)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domains, nil
+}
+
+func (c *Client) RemoveAutoSSLExcludedDomains(input AutoSSLExcludedDomainsUpdateModel) (*AutoSSLExcludedDomainsUpdateDataSourceModel, error) {
+	domains := AutoSSLExcludedDomainsUpdateDataSourceModel{}
+	err := c.executeOperation(OperationRemoveAutoSSLExcludedDomains, map[string]string{"domains": strings.Join(input.Domains, ",")}, &domains)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &domains, nil
+}
+
+func (c *Client) GetAutoSSLProblems() (*AutoSSLProblemsDataSourceModel, error) {
+	problems := AutoSSLProblemsDataSourceModel{}
+	err := c.executeOperation(OperationGetAutoSSLProblems, map[string]string{}, &problems)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &problems, nil
+}
+
+func (c *Client) IsAutoSSLCheckInProgress() (bool, error) {
+	inProgress := AutoSSLCheckInProgressDataSourceModel{}
+	err := c.executeOperation(OperationIsAutoSSLCheckInProgress, map[string]string{}, &inProgress)
+
+	if err != nil {
+		return false, err
+	}
+
+	return inProgress.Data == 1, nil
+}
+
+func (c *Client) StartAutoSSLCheck() (*AutoSSLCheckStartDataSourceModel, error) {
+	check := AutoSSLCheckStartDataSourceModel{}
+	err := c.executeOperation(OperationStartAutoSSLCheck, map[string]string{}, &check)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
@@ -0,0 +1,42 @@
+package ssl
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type AutoSSLExcludedDomainsDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []AutoSSLExcludedDomainDataSourceDataModel `tfsdk:"data"`
+}
+
+type AutoSSLExcludedDomainDataSourceDataModel struct {
+	ExcludedDomain string `json:"excluded_domain" tfsdk:"excluded_domain"`
+}
+
+type AutoSSLExcludedDomainsUpdateModel struct {
+	Domains []string `tfsdk:"domains"`
+}
+
+type AutoSSLExcludedDomainsUpdateDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+}
+
+type AutoSSLProblemsDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []AutoSSLProblemDataSourceDataModel `tfsdk:"data"`
+}
+
+// AutoSSLProblemDataSourceDataModel is a problem reported by the last AutoSSL check,
+// Time being in ISO 8601 format.
+type AutoSSLProblemDataSourceDataModel struct {
+	Domain  string `tfsdk:"domain"`
+	Problem string `tfsdk:"problem"`
+	Time    string `tfsdk:"time"`
+}
+
+type AutoSSLCheckInProgressDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data int64 `tfsdk:"data"`
+}
+
+type AutoSSLCheckStartDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+}
@@ -0,0 +1,12 @@
+package ssl
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationGetAutoSSLExcludedDomains    = cpanel.ReadOperation("get_autossl_excluded_domains")
+	OperationAddAutoSSLExcludedDomains    = cpanel.WriteOperation("add_autossl_excluded_domains")
+	OperationRemoveAutoSSLExcludedDomains = cpanel.WriteOperation("remove_autossl_excluded_domains")
+	OperationGetAutoSSLProblems           = cpanel.ReadOperation("get_autossl_problems")
+	OperationIsAutoSSLCheckInProgress     = cpanel.ReadOperation("is_autossl_check_in_progress")
+	OperationStartAutoSSLCheck            = cpanel.WriteOperation("start_autossl_check")
+)
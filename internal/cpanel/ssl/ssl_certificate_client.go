@@ -0,0 +1,80 @@
+package ssl
+
+import "strings"
+
+func (c *Client) InstallCertificate(input CertificateInstallModel) (*CertificateInstallDataSourceModel, error) {
+	certificate := CertificateInstallDataSourceModel{}
+	err := c.executeOperation(OperationInstallSSL, map[string]string{
+		"domain":   input.Domain,
+		"cert":     input.Certificate,
+		"key":      input.Key,
+		"cabundle": input.CABundle,
+	}, &certificate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+func (c *Client) GetInstalledHosts() (*InstalledHostsDataSourceModel, error) {
+	hosts := InstalledHostsDataSourceModel{}
+	err := c.executeOperation(OperationInstalledHosts, map[string]string{}, &hosts)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &hosts, nil
+}
+
+// GetInstalledHost returns the virtual host serving the domain, or nil if the domain has
+// no certificate installed.
+func (c *Client) GetInstalledHost(domain string) (*InstalledHostDataSourceDataModel, error) {
+	hosts, err := c.GetInstalledHosts()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, host := range hosts.Data {
+		if strings.EqualFold(host.ServerName, domain) {
+			return &hosts.Data[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetKeyAndCABundle returns the private key and the CA bundle stored for an installed
+// certificate.
+func (c *Client) GetKeyAndCABundle(certificate string) (*KeyAndCABundleDataSourceModel, error) {
+	keyAndCABundle := KeyAndCABundleDataSourceModel{}
+	err := c.executeOperation(OperationFetchKeyAndCABundle, map[string]string{"certificate": certificate}, &keyAndCABundle)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyAndCABundle, nil
+}
+
+func (c *Client) DeleteCertificate(input CertificateDeleteModel) (*CertificateDeleteDataSourceModel, error) {
+	certificate := CertificateDeleteDataSourceModel{}
+	err := c.executeOperation(OperationDeleteSSL, map[string]string{"domain": input.Domain}, &certificate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate, nil
+}
+
+// SamePEM compares two PEM documents, ignoring line endings and surrounding whitespace.
+func SamePEM(a, b string) bool {
+	normalize := func(pem string) string {
+		return strings.TrimSpace(strings.ReplaceAll(pem, "\r\n", "\n"))
+	}
+
+	return normalize(a) == normalize(b)
+}
@@ -0,0 +1,62 @@
+package ssl
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type CertificateInstallModel struct {
+	Domain      string `tfsdk:"domain"`
+	Certificate string `tfsdk:"cert"`
+	Key         string `tfsdk:"key"`
+	CABundle    string `tfsdk:"cabundle"`
+}
+
+type CertificateInstallDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data CertificateInstallDataSourceDataModel `tfsdk:"data"`
+}
+
+type CertificateInstallDataSourceDataModel struct {
+	CertID  string `json:"cert_id" tfsdk:"cert_id"`
+	Domain  string `tfsdk:"domain"`
+	Message string `tfsdk:"message"`
+}
+
+type InstalledHostsDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []InstalledHostDataSourceDataModel `tfsdk:"data"`
+}
+
+// InstalledHostDataSourceDataModel is a virtual host along with its certificate.
+type InstalledHostDataSourceDataModel struct {
+	ServerName      string                        `tfsdk:"servername"`
+	FQDNs           []string                      `tfsdk:"fqdns"`
+	Certificate     InstalledCertificateDataModel `tfsdk:"certificate"`
+	CertificateText string                        `json:"certificate_text" tfsdk:"certificate_text"`
+}
+
+// InstalledCertificateDataModel is a parsed certificate, NotAfter being a Unix timestamp.
+type InstalledCertificateDataModel struct {
+	ID                     string   `tfsdk:"id"`
+	Domains                []string `tfsdk:"domains"`
+	NotAfter               int64    `json:"not_after" tfsdk:"not_after"`
+	IssuerOrganizationName string   `json:"issuer.organizationName" tfsdk:"issuer.organizationName"`
+	IssuerCommonName       string   `json:"issuer.commonName" tfsdk:"issuer.commonName"`
+}
+
+type KeyAndCABundleDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data KeyAndCABundleDataSourceDataModel `tfsdk:"data"`
+}
+
+type KeyAndCABundleDataSourceDataModel struct {
+	Certificate string `tfsdk:"certificate"`
+	Key         string `tfsdk:"key"`
+	CABundle    string `json:"cab" tfsdk:"cab"`
+}
+
+type CertificateDeleteModel struct {
+	Domain string `tfsdk:"domain"`
+}
+
+type CertificateDeleteDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+}
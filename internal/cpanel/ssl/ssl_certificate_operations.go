@@ -0,0 +1,10 @@
+package ssl
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationInstallSSL          = cpanel.WriteOperation("install_ssl")
+	OperationInstalledHosts      = cpanel.ReadOperation("installed_hosts")
+	OperationFetchKeyAndCABundle = cpanel.ReadOperation("fetch_key_and_cabundle_for_certificate")
+	OperationDeleteSSL           = cpanel.WriteOperation("delete_ssl")
+)
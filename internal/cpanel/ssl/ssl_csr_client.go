@@ -0,0 +1,56 @@
+package ssl
+
+import "strings"
+
+func (c *Client) CreateCSR(input CSRCreateModel) (*CSRCreateDataSourceModel, error) {
+	params := map[string]string{
+		"key_id":              input.KeyID,
+		"domains":             strings.Join(input.Domains, ","),
+		"countryName":         input.CountryName,
+		"stateOrProvinceName": input.StateOrProvinceName,
+		"localityName":        input.LocalityName,
+		"organizationName":    input.OrganizationName,
+	}
+
+	optionalParams := map[string]string{
+		"organizationalUnitName": input.OrganizationalUnitName,
+		"emailAddress":           input.EmailAddress,
+		"friendly_name":          input.FriendlyName,
+	}
+	for name, value := range optionalParams {
+		if value != "" {
+			params[name] = value
+		}
+	}
+
+	csr := CSRCreateDataSourceModel{}
+	err := c.executeOperation(OperationGenerateCSR, params, &csr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &csr, nil
+}
+
+func (c *Client) GetCSR(id string) (*CSRShowDataSourceModel, error) {
+	csr := CSRShowDataSourceModel{}
+	err := c.executeOperation(OperationShowCSR, map[string]string{"id": id}, &csr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &csr, nil
+}
+
+func (c *Client) DeleteCSR(input CSRDeleteModel) (*CSRDeleteDataSourceModel, error) {
+	csr := CSRDeleteDataSourceModel{}
+	err := c.executeOperation(OperationDeleteCSR, map[string]string{"id": input.ID}, &csr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &csr, nil
+}
@@ -0,0 +1,65 @@
+package ssl
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+type CSRCreateModel struct {
+	KeyID                  string   `tfsdk:"key_id"`
+	Domains                []string `tfsdk:"domains"`
+	CountryName            string   `tfsdk:"countryName"`
+	StateOrProvinceName    string   `tfsdk:"stateOrProvinceName"`
+	LocalityName           string   `tfsdk:"localityName"`
+	OrganizationName       string   `tfsdk:"organizationName"`
+	OrganizationalUnitName string   `tfsdk:"organizationalUnitName"`
+	EmailAddress           string   `tfsdk:"emailAddress"`
+	FriendlyName           string   `tfsdk:"friendly_name"`
+}
+
+type CSRCreateDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data CSRDataSourceDataModel `tfsdk:"data"`
+}
+
+type CSRShowDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data CSRShowDataSourceDataModel `tfsdk:"data"`
+}
+
+type CSRShowDataSourceDataModel struct {
+	Details CSRDataSourceDataModel `tfsdk:"details"`
+	Text    string                 `tfsdk:"text"`
+}
+
+// CSRDataSourceDataModel is a certificate signing request, Text holding its PEM when it is
+// returned.
+type CSRDataSourceDataModel struct {
+	ID                     string   `tfsdk:"id"`
+	FriendlyName           string   `json:"friendly_name" tfsdk:"friendly_name"`
+	CommonName             string   `tfsdk:"commonName"`
+	Domains                []string `tfsdk:"domains"`
+	CountryName            string   `tfsdk:"countryName"`
+	StateOrProvinceName    string   `tfsdk:"stateOrProvinceName"`
+	LocalityName           string   `tfsdk:"localityName"`
+	OrganizationName       string   `tfsdk:"organizationName"`
+	OrganizationalUnitName string   `tfsdk:"organizationalUnitName"`
+	EmailAddress           string   `tfsdk:"emailAddress"`
+	Modulus                string   `tfsdk:"modulus"`
+	ECDSAPublic            string   `json:"ecdsa_public" tfsdk:"ecdsa_public"`
+	Text                   string   `tfsdk:"text"`
+}
+
+// PublicKey identifies the public part of the key the request was generated with.
+func (c CSRDataSourceDataModel) PublicKey() string {
+	if c.ECDSAPublic != "" {
+		return c.ECDSAPublic
+	}
+
+	return c.Modulus
+}
+
+type CSRDeleteModel struct {
+	ID string `tfsdk:"id"`
+}
+
+type CSRDeleteDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+}
@@ -0,0 +1,9 @@
+package ssl
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationGenerateCSR = cpanel.WriteOperation("generate_csr")
+	OperationShowCSR     = cpanel.ReadOperation("show_csr")
+	OperationDeleteCSR   = cpanel.WriteOperation("delete_csr")
+)
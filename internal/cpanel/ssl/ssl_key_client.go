@@ -0,0 +1,50 @@
+package ssl
+
+func (c *Client) CreateKey(input KeyCreateModel) (*KeyCreateDataSourceModel, error) {
+	params := map[string]string{"key_type": input.KeyType}
+	if input.FriendlyName != "" {
+		params["friendly_name"] = input.FriendlyName
+	}
+
+	key := KeyCreateDataSourceModel{}
+	err := c.executeOperation(OperationGenerateKey, params, &key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (c *Client) GetKeys() (*KeysDataSourceModel, error) {
+	keys := KeysDataSourceModel{}
+	err := c.executeOperation(OperationListKeys, map[string]string{}, &keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &keys, nil
+}
+
+func (c *Client) GetKey(id string) (*KeyShowDataSourceModel, error) {
+	key := KeyShowDataSourceModel{}
+	err := c.executeOperation(OperationShowKey, map[string]string{"id": id}, &key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (c *Client) DeleteKey(input KeyDeleteModel) (*KeyDeleteDataSourceModel, error) {
+	key := KeyDeleteDataSourceModel{}
+	err := c.executeOperation(OperationDeleteKey, map[string]string{"id": input.ID}, &key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
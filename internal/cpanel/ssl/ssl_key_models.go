@@ -0,0 +1,73 @@
+package ssl
+
+import (
+	"strconv"
+	"terraform-provider-cpanel/internal/cpanel"
+)
+
+// KeyTypes lists the key types generate_key accepts.
+var KeyTypes = []string{"rsa-2048", "rsa-4096", "ecdsa-prime256v1", "ecdsa-secp384r1"}
+
+type KeyCreateModel struct {
+	FriendlyName string `tfsdk:"friendly_name"`
+	KeyType      string `tfsdk:"key_type"`
+}
+
+type KeyCreateDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data KeyDataSourceDataModel `tfsdk:"data"`
+}
+
+type KeysDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data []KeyDataSourceDataModel `tfsdk:"data"`
+}
+
+type KeyShowDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+	Data KeyShowDataSourceDataModel `tfsdk:"data"`
+}
+
+type KeyShowDataSourceDataModel struct {
+	Key  KeyDataSourceDataModel `tfsdk:"key"`
+	Text string                 `tfsdk:"text"`
+}
+
+// KeyDataSourceDataModel is a private key, Text holding its PEM when it is returned.
+type KeyDataSourceDataModel struct {
+	ID             string `tfsdk:"id"`
+	FriendlyName   string `json:"friendly_name" tfsdk:"friendly_name"`
+	Modulus        string `tfsdk:"modulus"`
+	KeyAlgorithm   string `json:"key_algorithm" tfsdk:"key_algorithm"`
+	ModulusLength  int64  `json:"modulus_length" tfsdk:"modulus_length"`
+	ECDSACurveName string `json:"ecdsa_curve_name" tfsdk:"ecdsa_curve_name"`
+	ECDSAPublic    string `json:"ecdsa_public" tfsdk:"ecdsa_public"`
+	Text           string `tfsdk:"text"`
+}
+
+// KeyType returns the type of the key, as accepted by generate_key.
+func (k KeyDataSourceDataModel) KeyType() string {
+	if k.ECDSACurveName != "" {
+		return "ecdsa-" + k.ECDSACurveName
+	}
+
+	return "rsa-" + strconv.FormatInt(k.ModulusLength, 10)
+}
+
+// PublicKey identifies the public part of the key, to match it with certificates and
+// certificate signing requests.
+func (k KeyDataSourceDataModel) PublicKey() string {
+	if k.ECDSAPublic != "" {
+		return k.ECDSAPublic
+	}
+
+	return k.Modulus
+}
+
+type KeyDeleteModel struct {
+	ID string `tfsdk:"id"`
+}
+
+type KeyDeleteDataSourceModel struct {
+	cpanel.UAPIDataSourceModel
+}
@@ -0,0 +1,10 @@
+package ssl
+
+import "terraform-provider-cpanel/internal/cpanel"
+
+var (
+	OperationGenerateKey = cpanel.WriteOperation("generate_key")
+	OperationListKeys    = cpanel.ReadOperation("list_keys")
+	OperationShowKey     = cpanel.ReadOperation("show_key")
+	OperationDeleteKey   = cpanel.WriteOperation("delete_key")
+)
@@ -0,0 +1,147 @@
+package cronexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Describe returns the schedule in plain English, such as "At 02:30 on Monday".
+func (s Schedule) Describe() (string, error) {
+	if err := s.Validate(); err != nil {
+		return "", err
+	}
+
+	parts := []string{s.describeTime()}
+
+	// Like cron, the days matching either the day of month or the day of week are run when both are restricted
+	var days []string
+	if s.Day != "*" {
+		description := describeField(Day, s.Day, "on day", "on days", "days")
+		if !strings.HasPrefix(s.Day, "*/") {
+			description += " of the month"
+		}
+		days = append(days, description)
+	}
+	if s.Weekday != "*" {
+		days = append(days, describeField(Weekday, s.Weekday, "on", "on", "days of the week"))
+	}
+	if len(days) == 2 && !strings.HasPrefix(s.Day, "*") && !strings.HasPrefix(s.Weekday, "*") {
+		days = []string{days[0] + " or " + days[1]}
+	}
+	parts = append(parts, days...)
+
+	if s.Month != "*" {
+		parts = append(parts, describeField(Month, s.Month, "in", "in", "months"))
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+func (s Schedule) describeTime() string {
+	minute, singleMinute := singleValue(Minute, s.Minute)
+	if singleMinute && s.Hour != "*" {
+		var times []string
+		for _, element := range strings.Split(s.Hour, ",") {
+			hour, ok := singleValue(Hour, element)
+			if !ok {
+				times = nil
+				break
+			}
+			times = append(times, fmt.Sprintf("%02d:%02d", hour, minute))
+		}
+
+		if times != nil {
+			return "At " + joinList(times)
+		}
+	}
+
+	var description string
+	switch {
+	case s.Minute == "*":
+		description = "Every minute"
+	case strings.HasPrefix(s.Minute, "*/"):
+		description = "Every " + strings.TrimPrefix(s.Minute, "*/") + " minutes"
+	default:
+		description = describeField(Minute, s.Minute, "At minute", "At minutes", "minutes")
+	}
+
+	if s.Hour == "*" {
+		if singleMinute {
+			description += " past every hour"
+		}
+		return description
+	}
+
+	return description + ", " + describeField(Hour, s.Hour, "during hour", "during hours", "hours")
+}
+
+// describeField describes the values of a field, such as "on days 1 and 15" or "every 2 days",
+// with the prefix for a single value, the prefix for several values and the unit of the steps.
+func describeField(field Field, expression, singlePrefix, pluralPrefix, stepUnit string) string {
+	if strings.HasPrefix(expression, "*/") {
+		return "every " + strings.TrimPrefix(expression, "*/") + " " + stepUnit
+	}
+
+	var elements []string
+	plural := false
+	for _, element := range strings.Split(expression, ",") {
+		rangeExpression, step, hasStep := strings.Cut(element, "/")
+		start, end, isRange := strings.Cut(rangeExpression, "-")
+
+		description := field.valueName(start)
+		if rangeExpression == "*" {
+			description = field.valueName(strconv.Itoa(field.Min)) + " through " + field.valueName(strconv.Itoa(field.Max))
+		} else if isRange {
+			description += " through " + field.valueName(end)
+		} else if hasStep {
+			description += " through " + field.valueName(strconv.Itoa(field.Max))
+		}
+		if hasStep {
+			description += " every " + step
+		}
+
+		plural = plural || isRange || hasStep || rangeExpression == "*"
+		elements = append(elements, description)
+	}
+
+	if plural || len(elements) > 1 {
+		return pluralPrefix + " " + joinList(elements)
+	}
+
+	return singlePrefix + " " + elements[0]
+}
+
+// valueName returns the name of a month or a day of week, and the number of the other values.
+func (f Field) valueName(expression string) string {
+	value, err := f.parseValue(expression)
+	if err != nil {
+		return expression
+	}
+
+	switch f.Name {
+	case Month.Name:
+		return time.Month(value).String()
+	case Weekday.Name:
+		return time.Weekday(value % 7).String()
+	default:
+		return strconv.Itoa(value)
+	}
+}
+
+// singleValue returns the value of an expression holding a single value.
+func singleValue(field Field, expression string) (int, bool) {
+	value, err := field.parseValue(expression)
+
+	return value, err == nil
+}
+
+// joinList joins the elements as in "a, b and c".
+func joinList(elements []string) string {
+	if len(elements) == 1 {
+		return elements[0]
+	}
+
+	return strings.Join(elements[:len(elements)-1], ", ") + " and " + elements[len(elements)-1]
+}
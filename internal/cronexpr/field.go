@@ -0,0 +1,125 @@
+// Package cronexpr parses the cron expressions of the crontab lines: the five
+// schedule fields, and the macros such as @daily standing for them.
+package cronexpr
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Field describes a schedule field, its range and the names allowed for its values.
+type Field struct {
+	Name string
+	Min  int
+	Max  int
+	// Names are the names of the values from Min, such as JAN for 1.
+	Names []string
+}
+
+var (
+	Minute  = Field{Name: "minute", Min: 0, Max: 59}
+	Hour    = Field{Name: "hour", Min: 0, Max: 23}
+	Day     = Field{Name: "day of month", Min: 1, Max: 31}
+	Month   = Field{Name: "month", Min: 1, Max: 12, Names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	Weekday = Field{Name: "day of week", Min: 0, Max: 7, Names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+)
+
+// Values is the set of the values matched by a field, as a bit mask.
+type Values uint64
+
+// Contains reports whether the value is matched.
+func (v Values) Contains(value int) bool {
+	return value >= 0 && value < 64 && v&(1<<value) != 0
+}
+
+// Parse returns the values matched by the expression, a comma-separated list of
+// *, values, ranges such as 1-5, and steps such as */15 or 0-30/10.
+func (f Field) Parse(expression string) (Values, error) {
+	if expression == "" {
+		return 0, fmt.Errorf("the %s must not be empty", f.Name)
+	}
+
+	var values Values
+	for _, element := range strings.Split(expression, ",") {
+		elementValues, err := f.parseElement(element)
+		if err != nil {
+			return 0, err
+		}
+
+		values |= elementValues
+	}
+
+	// Sunday is both 0 and 7
+	if f.Name == Weekday.Name && values.Contains(7) {
+		values = values&^(1<<7) | 1
+	}
+
+	return values, nil
+}
+
+func (f Field) parseElement(element string) (Values, error) {
+	rangeExpression, stepExpression, hasStep := strings.Cut(element, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepExpression)
+		if err != nil || step < 1 {
+			return 0, fmt.Errorf("invalid %s step %q", f.Name, stepExpression)
+		}
+	}
+
+	var first, last int
+	switch startExpression, endExpression, isRange := strings.Cut(rangeExpression, "-"); {
+	case rangeExpression == "*":
+		first, last = f.Min, f.Max
+	case isRange:
+		var err error
+		if first, err = f.parseValue(startExpression); err != nil {
+			return 0, err
+		}
+		if last, err = f.parseValue(endExpression); err != nil {
+			return 0, err
+		}
+		if first > last {
+			return 0, fmt.Errorf("invalid %s range %q, its start is after its end", f.Name, rangeExpression)
+		}
+	default:
+		var err error
+		if first, err = f.parseValue(rangeExpression); err != nil {
+			return 0, err
+		}
+
+		// A single value with a step, such as 5/15, runs from the value to the maximum
+		last = first
+		if hasStep {
+			last = f.Max
+		}
+	}
+
+	var values Values
+	for value := first; value <= last; value += step {
+		values |= 1 << value
+	}
+
+	return values, nil
+}
+
+func (f Field) parseValue(expression string) (int, error) {
+	for i, name := range f.Names {
+		if strings.EqualFold(expression, name) {
+			return f.Min + i, nil
+		}
+	}
+
+	value, err := strconv.Atoi(expression)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", f.Name, expression)
+	}
+	if value < f.Min || value > f.Max {
+		return 0, fmt.Errorf("%s %d is out of range %d-%d", f.Name, value, f.Min, f.Max)
+	}
+
+	return value, nil
+}
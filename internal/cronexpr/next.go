@@ -0,0 +1,54 @@
+package cronexpr
+
+import (
+	"strings"
+	"time"
+)
+
+// searchYears bounds the search of the next runs, for schedules such as February 30 which never run.
+const searchYears = 5
+
+// Next returns up to count run times after the time, in its location. Like cron, when both the day of
+// month and the day of week are restricted, the days matching either of them are run.
+func (s Schedule) Next(after time.Time, count int) ([]time.Time, error) {
+	var fields [5]Values
+	for i, field := range s.fields() {
+		values, err := field.field.Parse(field.expression)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = values
+	}
+	minutes, hours, days, months, weekdays := fields[0], fields[1], fields[2], fields[3], fields[4]
+	eitherDay := !strings.HasPrefix(s.Day, "*") && !strings.HasPrefix(s.Weekday, "*")
+
+	location := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	end := t.AddDate(searchYears, 0, 0)
+
+	var runs []time.Time
+	for len(runs) < count && t.Before(end) {
+		year, month, day := t.Date()
+
+		dayMatches := days.Contains(day) && weekdays.Contains(int(t.Weekday()))
+		if eitherDay {
+			dayMatches = days.Contains(day) || weekdays.Contains(int(t.Weekday()))
+		}
+
+		switch {
+		case !months.Contains(int(month)):
+			t = time.Date(year, month+1, 1, 0, 0, 0, 0, location)
+		case !dayMatches:
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, location)
+		case !hours.Contains(t.Hour()):
+			t = time.Date(year, month, day, t.Hour()+1, 0, 0, 0, location)
+		case !minutes.Contains(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			runs = append(runs, t)
+			t = t.Add(time.Minute)
+		}
+	}
+
+	return runs, nil
+}
@@ -0,0 +1,78 @@
+package cronexpr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Macros are the schedules standing for the five fields.
+var Macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule holds the five fields of a crontab line.
+type Schedule struct {
+	Minute  string
+	Hour    string
+	Day     string
+	Month   string
+	Weekday string
+}
+
+// ParseSchedule parses a schedule such as "*/5 * * * *" or "@daily" and checks its fields.
+func ParseSchedule(expression string) (Schedule, error) {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "@") {
+		expanded, ok := Macros[strings.ToLower(expression)]
+		if !ok {
+			return Schedule{}, fmt.Errorf("unsupported macro %q", expression)
+		}
+		expression = expanded
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("expected 5 fields (minute, hour, day of month, month and day of week), got %d", len(fields))
+	}
+
+	schedule := Schedule{Minute: fields[0], Hour: fields[1], Day: fields[2], Month: fields[3], Weekday: fields[4]}
+
+	return schedule, schedule.Validate()
+}
+
+// Validate checks the expression of every field.
+func (s Schedule) Validate() error {
+	for _, field := range s.fields() {
+		if _, err := field.field.Parse(field.expression); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns the five fields separated by spaces.
+func (s Schedule) String() string {
+	return strings.Join([]string{s.Minute, s.Hour, s.Day, s.Month, s.Weekday}, " ")
+}
+
+type scheduleField struct {
+	field      Field
+	expression string
+}
+
+func (s Schedule) fields() []scheduleField {
+	return []scheduleField{
+		{Minute, s.Minute},
+		{Hour, s.Hour},
+		{Day, s.Day},
+		{Month, s.Month},
+		{Weekday, s.Weekday},
+	}
+}
@@ -0,0 +1,155 @@
+package cronexpr
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFieldParse(t *testing.T) {
+	tests := []struct {
+		field      Field
+		expression string
+		expected   []int
+	}{
+		{Minute, "*/15", []int{0, 15, 30, 45}},
+		{Minute, "0,30", []int{0, 30}},
+		{Minute, "5/20", []int{5, 25, 45}},
+		{Hour, "9-17/4", []int{9, 13, 17}},
+		{Day, "1,15-16", []int{1, 15, 16}},
+		{Month, "jan-MAR,dec", []int{1, 2, 3, 12}},
+		{Weekday, "MON-FRI", []int{1, 2, 3, 4, 5}},
+		{Weekday, "7", []int{0}},
+		{Weekday, "5-7", []int{0, 5, 6}},
+	}
+
+	for _, test := range tests {
+		values, err := test.field.Parse(test.expression)
+		if err != nil {
+			t.Errorf("%s %q: unexpected error: %s", test.field.Name, test.expression, err)
+			continue
+		}
+
+		var got []int
+		for value := 0; value < 64; value++ {
+			if values.Contains(value) {
+				got = append(got, value)
+			}
+		}
+		if len(got) != len(test.expected) {
+			t.Errorf("%s %q: expected %v, got %v", test.field.Name, test.expression, test.expected, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.expected[i] {
+				t.Errorf("%s %q: expected %v, got %v", test.field.Name, test.expression, test.expected, got)
+				break
+			}
+		}
+	}
+}
+
+func TestFieldParseErrors(t *testing.T) {
+	tests := []struct {
+		field      Field
+		expression string
+		expected   string
+	}{
+		{Minute, "60", "minute 60 is out of range 0-59"},
+		{Hour, "*/0", `invalid hour step "0"`},
+		{Day, "0", "day of month 0 is out of range 1-31"},
+		{Day, "20-10", `invalid day of month range "20-10"`},
+		{Month, "JANUARY", `invalid month "JANUARY"`},
+		{Weekday, "1-8", "day of week 8 is out of range 0-7"},
+		{Weekday, "", "the day of week must not be empty"},
+		{Minute, "1,,2", `invalid minute ""`},
+	}
+
+	for _, test := range tests {
+		_, err := test.field.Parse(test.expression)
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("%s %q: expected error %q, got %v", test.field.Name, test.expression, test.expected, err)
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	tests := map[string]Schedule{
+		"*/5 * * * *":      {Minute: "*/5", Hour: "*", Day: "*", Month: "*", Weekday: "*"},
+		"  30 2  * * 1-5 ": {Minute: "30", Hour: "2", Day: "*", Month: "*", Weekday: "1-5"},
+		"@daily":           {Minute: "0", Hour: "0", Day: "*", Month: "*", Weekday: "*"},
+		"@Weekly":          {Minute: "0", Hour: "0", Day: "*", Month: "*", Weekday: "0"},
+	}
+
+	for expression, expected := range tests {
+		schedule, err := ParseSchedule(expression)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", expression, err)
+		} else if schedule != expected {
+			t.Errorf("%q: expected %+v, got %+v", expression, expected, schedule)
+		}
+	}
+
+	for _, expression := range []string{"@reboot", "* * * *", "* * * * * *", "* 24 * * *"} {
+		if _, err := ParseSchedule(expression); err == nil {
+			t.Errorf("%q: expected an error", expression)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	after := time.Date(2024, time.February, 27, 10, 7, 30, 0, time.UTC)
+
+	tests := map[string][]string{
+		"*/20 * * * *": {"2024-02-27T10:20:00Z", "2024-02-27T10:40:00Z", "2024-02-27T11:00:00Z"},
+		"30 2 * * MON": {"2024-03-04T02:30:00Z", "2024-03-11T02:30:00Z", "2024-03-18T02:30:00Z"},
+		"0 0 29 2 *":   {"2024-02-29T00:00:00Z", "2028-02-29T00:00:00Z"},
+		"0 12 1 * 5":   {"2024-03-01T12:00:00Z", "2024-03-08T12:00:00Z", "2024-03-15T12:00:00Z"},
+		"0 0 30 2 *":   nil,
+	}
+
+	for expression, expected := range tests {
+		schedule, err := ParseSchedule(expression)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", expression, err)
+		}
+
+		runs, err := schedule.Next(after, 3)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", expression, err)
+		}
+
+		var got []string
+		for _, run := range runs {
+			got = append(got, run.Format(time.RFC3339))
+		}
+		if strings.Join(got, " ") != strings.Join(expected, " ") {
+			t.Errorf("%q: expected %v, got %v", expression, expected, got)
+		}
+	}
+}
+
+func TestScheduleDescribe(t *testing.T) {
+	tests := map[string]string{
+		"30 2 * * 1":         "At 02:30 on Monday",
+		"@daily":             "At 00:00",
+		"*/5 * * * *":        "Every 5 minutes",
+		"5 * * * *":          "At minute 5 past every hour",
+		"0 9-17 * * MON-FRI": "At minute 0, during hours 9 through 17 on Monday through Friday",
+		"0 0,12 * * SAT,7":   "At 00:00 and 12:00 on Saturday and Sunday",
+		"0 0 1 1 *":          "At 00:00 on day 1 of the month in January",
+		"0 0 */2 JAN-MAR *":  "At 00:00 every 2 days in January through March",
+		"0 0 1 * 1":          "At 00:00 on day 1 of the month or on Monday",
+	}
+
+	for expression, expected := range tests {
+		schedule, err := ParseSchedule(expression)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", expression, err)
+		}
+
+		if description, err := schedule.Describe(); err != nil || description != expected {
+			t.Errorf("%q: expected %q, got %q (%v)", expression, expected, description, err)
+		}
+	}
+}
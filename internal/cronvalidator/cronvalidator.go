@@ -0,0 +1,100 @@
+// Package cronvalidator provides validators for the cron expressions of the
+// crontab lines, so that invalid schedules are reported by terraform validate.
+package cronvalidator
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"terraform-provider-cpanel/internal/cronexpr"
+)
+
+var _ validator.String = fieldValidator{}
+var _ validator.String = scheduleValidator{}
+
+type fieldValidator struct {
+	field cronexpr.Field
+}
+
+// Description describes the validation in plain text formatting.
+func (v fieldValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("must be a valid %s expression, a comma-separated list of *, values from %d to %d, ranges and steps", v.field.Name, v.field.Min, v.field.Max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v fieldValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v fieldValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := v.field.Parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			fmt.Sprintf("must be a valid %s expression (%s)", v.field.Name, err),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// Minute returns a validator which ensures that the string is a valid minute expression, such as */5 or 0,30.
+func Minute() validator.String {
+	return fieldValidator{field: cronexpr.Minute}
+}
+
+// Hour returns a validator which ensures that the string is a valid hour expression, such as 9-17.
+func Hour() validator.String {
+	return fieldValidator{field: cronexpr.Hour}
+}
+
+// Day returns a validator which ensures that the string is a valid day of month expression, such as 1,15.
+func Day() validator.String {
+	return fieldValidator{field: cronexpr.Day}
+}
+
+// Month returns a validator which ensures that the string is a valid month expression, such as */3 or JAN-JUN.
+func Month() validator.String {
+	return fieldValidator{field: cronexpr.Month}
+}
+
+// Weekday returns a validator which ensures that the string is a valid day of week expression, such as 1-5 or MON.
+func Weekday() validator.String {
+	return fieldValidator{field: cronexpr.Weekday}
+}
+
+type scheduleValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v scheduleValidator) Description(_ context.Context) string {
+	return "must be a valid cron schedule, five fields such as */5 * * * * or a macro such as @daily"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v scheduleValidator) MarkdownDescription(_ context.Context) string {
+	return "must be a valid cron schedule, five fields such as `*/5 * * * *` or a macro such as `@daily`"
+}
+
+// ValidateString performs the validation.
+func (v scheduleValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := cronexpr.ParseSchedule(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			fmt.Sprintf("must be a valid cron schedule (%s)", err),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// Schedule returns a validator which ensures that the string is a valid cron schedule.
+func Schedule() validator.String {
+	return scheduleValidator{}
+}
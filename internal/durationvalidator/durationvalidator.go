@@ -0,0 +1,45 @@
+// Package durationvalidator provides validators for the durations of the
+// provider settings, so that invalid values are reported by terraform validate.
+package durationvalidator
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"time"
+)
+
+var _ validator.String = positiveValidator{}
+
+type positiveValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v positiveValidator) Description(_ context.Context) string {
+	return "must be a positive duration such as 10s or 1m"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v positiveValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v positiveValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.Path,
+			v.Description(ctx),
+			req.ConfigValue.ValueString(),
+		))
+	}
+}
+
+// Positive returns a validator which ensures that the string is a positive Go duration, such as 30s or 1m30s.
+func Positive() validator.String {
+	return positiveValidator{}
+}
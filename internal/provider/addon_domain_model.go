@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+type AddonDomainModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	Subdomain    types.String `tfsdk:"subdomain"`
+	DocumentRoot types.String `tfsdk:"document_root"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+// AddonDomainDefaultSubdomain returns the subdomain backing an addon domain when none
+// is configured: the first label of the domain.
+func AddonDomainDefaultSubdomain(domain string) string {
+	subdomain, _, _ := strings.Cut(domain, ".")
+
+	return subdomain
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &addonDomainResource{}
+	_ resource.ResourceWithConfigure   = &addonDomainResource{}
+	_ resource.ResourceWithImportState = &addonDomainResource{}
+)
+
+// NewAddonDomainResource is a helper function to simplify the provider implementation.
+func NewAddonDomainResource() resource.Resource {
+	return &addonDomainResource{}
+}
+
+// addonDomainResource is the resource implementation.
+type addonDomainResource struct {
+	client *domain.Client
+}
+
+// Metadata returns the resource type name.
+func (r *addonDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_addon_domain"
+}
+
+// Schema defines the schema for the resource.
+func (r *addonDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an addon domain, serving its own website from the account.",
+		MarkdownDescription: "Manages an addon domain, serving its own website from the account.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The addon domain.",
+				MarkdownDescription: "The addon domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subdomain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The subdomain of the main domain backing the addon domain. Defaults to the first label of the domain.",
+				MarkdownDescription: "The subdomain of the main domain backing the addon domain. Defaults to the first label of the domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"document_root": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The document root, relative to the home directory. Defaults to a directory named after the domain.",
+				MarkdownDescription: "The document root, relative to the home directory. Defaults to a directory named after the domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *addonDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state AddonDomainModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read addon domain
+	addonDomain, err := r.client.GetAddonDomain(state.Domain.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting addon domain",
+			"Could not get addon domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the addon domain from the state if it has been deleted outside Terraform
+	if addonDomain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Subdomain = types.StringValue(addonDomain.Subdomain)
+	state.DocumentRoot = types.StringValue(addonDomain.BaseDir)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *addonDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan AddonDomainModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Subdomain.IsUnknown() {
+		plan.Subdomain = types.StringValue(AddonDomainDefaultSubdomain(plan.Domain.ValueString()))
+	}
+
+	if plan.DocumentRoot.IsUnknown() {
+		plan.DocumentRoot = plan.Domain
+	}
+
+	// Create new addon domain
+	var addonDomain domain.AddonDomainCreateModel
+	addonDomain.Domain = plan.Domain.ValueString()
+	addonDomain.Subdomain = plan.Subdomain.ValueString()
+	addonDomain.Dir = plan.DocumentRoot.ValueString()
+
+	_, err := r.client.CreateAddonDomain(addonDomain)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating addon domain",
+			"Could not create addon domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *addonDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan AddonDomainModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find the main domain the backing subdomain belongs to
+	addonDomain, err := r.client.GetAddonDomain(plan.Domain.ValueString())
+
+	if err == nil && addonDomain == nil {
+		err = fmt.Errorf("the addon domain %s does not exist", plan.Domain.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating addon domain",
+			"Could not update addon domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Change the document root of the backing subdomain
+	var documentRoot domain.DocumentRootUpdateModel
+	documentRoot.Subdomain = addonDomain.Subdomain
+	documentRoot.RootDomain = addonDomain.RootDomain
+	documentRoot.Dir = plan.DocumentRoot.ValueString()
+
+	_, err = r.client.UpdateDocumentRoot(documentRoot)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating addon domain",
+			"Could not update addon domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *addonDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state AddonDomainModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Find the main domain the backing subdomain belongs to
+	addonDomain, err := r.client.GetAddonDomain(state.Domain.ValueString())
+
+	if err == nil && addonDomain != nil {
+		// Delete existing addon domain
+		_, err = r.client.DeleteAddonDomain(domain.AddonDomainDeleteModel{
+			Domain:     addonDomain.Domain,
+			Subdomain:  addonDomain.Subdomain,
+			RootDomain: addonDomain.RootDomain,
+		})
+	}
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting addon domain",
+			"Could not delete addon domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *addonDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *addonDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	domainClient, ok := providerData["domain"].(*domain.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Domain Client Type",
+			fmt.Sprintf("Expected *domain.Client, got: %T. Please report this issue to the provider developers.", providerData["domain"]),
+		)
+		return
+	}
+
+	r.client = domainClient
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+)
+
+func TestAccAddonDomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_addon_domain" "addon" {
+						domain = "tf-acc-addon.odns.fr"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_addon_domain.addon", "subdomain", "tf-acc-addon"),
+					resource.TestCheckResourceAttr("cpanel_addon_domain.addon", "document_root", "tf-acc-addon.odns.fr"),
+					resource.TestCheckResourceAttrSet("cpanel_addon_domain.addon", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_addon_domain.addon",
+				ImportStateId:                        "tf-acc-addon.odns.fr",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestAddonDomainResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_addon_domain" "addon" {
+						domain = "client.example.net"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_addon_domain.addon", "subdomain", "client"),
+					resource.TestCheckResourceAttr("cpanel_addon_domain.addon", "document_root", "client.example.net"),
+					resource.TestCheckResourceAttrSet("cpanel_addon_domain.addon", "last_updated"),
+					checkAddonDomain(server, "client.example.net", "client", "client.example.net"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_addon_domain.addon",
+				ImportStateId:                        "client.example.net",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() { server.AddAddonDomain("client.example.net", "client", "public_html/client") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_addon_domain" "addon" {
+						domain = "client.example.net"
+						document_root = "client.example.net"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update document root testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_addon_domain" "addon" {
+						domain = "client.example.net"
+						document_root = "sites/client"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_addon_domain.addon", "document_root", "sites/client"),
+					checkAddonDomain(server, "client.example.net", "client", "sites/client"),
+				),
+			},
+			// Replace on subdomain change testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_addon_domain" "addon" {
+						domain = "client.example.net"
+						subdomain = "clientsite"
+						document_root = "sites/client"
+					}
+				`,
+				Check: checkAddonDomain(server, "client.example.net", "clientsite", "sites/client"),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeleteAddonDomain("client.example.net") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_addon_domain" "addon" {
+						domain = "client.example.net"
+						subdomain = "clientsite"
+						document_root = "sites/client"
+					}
+				`,
+				Check: checkAddonDomain(server, "client.example.net", "clientsite", "sites/client"),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if server.AddonDomain("client.example.net") != nil {
+				return fmt.Errorf("expected the addon domain client.example.net to be deleted")
+			}
+			return nil
+		},
+	})
+}
+
+func TestAddonDomainResourceReadRemovesDeletedAddonDomain(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddAddonDomain("other.example.net", "other", "other.example.net")
+
+	r := &addonDomainResource{client: domain.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &AddonDomainModel{
+		Domain:       types.StringValue("client.example.net"),
+		Subdomain:    types.StringValue("client"),
+		DocumentRoot: types.StringValue("client.example.net"),
+		LastUpdated:  types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted addon domain to be removed from the state")
+	}
+}
+
+func checkAddonDomain(server *cpaneltest.Server, name, expectedSubdomain, expectedDir string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		addonDomain := server.AddonDomain(name)
+		if addonDomain == nil {
+			return fmt.Errorf("expected the addon domain %s to exist", name)
+		}
+		if addonDomain.Subdomain != expectedSubdomain {
+			return fmt.Errorf("expected the subdomain of %s to be %q, got %q", name, expectedSubdomain, addonDomain.Subdomain)
+		}
+		if addonDomain.Dir != expectedDir {
+			return fmt.Errorf("expected the document root of %s to be %q, got %q", name, expectedDir, addonDomain.Dir)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+)
+
+type AutoSSLExcludedDomainsModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Domains       []types.String `tfsdk:"domains"`
+	CheckOnChange types.Bool     `tfsdk:"check_on_change"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+}
+
+func AutoSSLExcludedDomainsAPIToModel(excludedDomains *ssl.AutoSSLExcludedDomainsDataSourceModel) []types.String {
+	domains := make([]types.String, 0, len(excludedDomains.Data))
+	for _, excludedDomain := range excludedDomains.Data {
+		domains = append(domains, types.StringValue(excludedDomain.ExcludedDomain))
+	}
+
+	return domains
+}
+
+// AutoSSLExcludedDomainsChanges returns the domains to exclude from AutoSSL and the ones to
+// include again, for the excluded domains to become the planned ones.
+func AutoSSLExcludedDomainsChanges(excludedDomains *ssl.AutoSSLExcludedDomainsDataSourceModel, planned []types.String) ([]string, []string) {
+	excluded := map[string]bool{}
+	for _, excludedDomain := range excludedDomains.Data {
+		excluded[strings.ToLower(excludedDomain.ExcludedDomain)] = true
+	}
+
+	var add []string
+	wanted := map[string]bool{}
+	for _, domain := range planned {
+		name := strings.ToLower(domain.ValueString())
+		wanted[name] = true
+		if !excluded[name] {
+			add = append(add, domain.ValueString())
+		}
+	}
+
+	var remove []string
+	for _, excludedDomain := range excludedDomains.Data {
+		if !wanted[strings.ToLower(excludedDomain.ExcludedDomain)] {
+			remove = append(remove, excludedDomain.ExcludedDomain)
+		}
+	}
+
+	return add, remove
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &autoSSLExcludedDomainsResource{}
+	_ resource.ResourceWithConfigure   = &autoSSLExcludedDomainsResource{}
+	_ resource.ResourceWithImportState = &autoSSLExcludedDomainsResource{}
+)
+
+// NewAutoSSLExcludedDomainsResource is a helper function to simplify the provider implementation.
+func NewAutoSSLExcludedDomainsResource() resource.Resource {
+	return &autoSSLExcludedDomainsResource{}
+}
+
+// autoSSLExcludedDomainsResource is the resource implementation.
+type autoSSLExcludedDomainsResource struct {
+	client *ssl.Client
+}
+
+// Metadata returns the resource type name.
+func (r *autoSSLExcludedDomainsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_autossl_excluded_domains"
+}
+
+// Schema defines the schema for the resource.
+func (r *autoSSLExcludedDomainsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the domains of the account excluded from AutoSSL. The list is authoritative: domains excluded outside Terraform are included again.",
+		MarkdownDescription: "Manages the domains of the account excluded from AutoSSL. The list is authoritative: domains excluded outside Terraform are included again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The cPanel username of the account.",
+				MarkdownDescription: "The cPanel username of the account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domains": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The domains AutoSSL must not request certificates for.",
+				MarkdownDescription: "The domains AutoSSL must not request certificates for.",
+			},
+			"check_on_change": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to start an AutoSSL check when the excluded domains change, for the domains included again to get a certificate without waiting for the next scheduled check. Defaults to false.",
+				MarkdownDescription: "Whether to start an AutoSSL check when the excluded domains change, for the domains included again to get a certificate without waiting for the next scheduled check. Defaults to `false`.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *autoSSLExcludedDomainsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state AutoSSLExcludedDomainsModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read excluded domains
+	excludedDomains, err := r.client.GetAutoSSLExcludedDomains()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting AutoSSL excluded domains",
+			"Could not get AutoSSL excluded domains, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Domains = AutoSSLExcludedDomainsAPIToModel(excludedDomains)
+	if state.CheckOnChange.IsNull() {
+		state.CheckOnChange = types.BoolValue(false)
+	}
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *autoSSLExcludedDomainsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan AutoSSLExcludedDomainsModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exclude the planned domains, and only them
+	err := r.setExcludedDomains(plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating AutoSSL excluded domains",
+			"Could not create AutoSSL excluded domains, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(r.client.Auth.Username)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *autoSSLExcludedDomainsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan AutoSSLExcludedDomainsModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exclude the planned domains, and only them
+	err := r.setExcludedDomains(plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating AutoSSL excluded domains",
+			"Could not update AutoSSL excluded domains, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *autoSSLExcludedDomainsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state AutoSSLExcludedDomainsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Include every domain in AutoSSL again
+	state.Domains = nil
+	err := r.setExcludedDomains(state)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting AutoSSL excluded domains",
+			"Could not delete AutoSSL excluded domains, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *autoSSLExcludedDomainsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *autoSSLExcludedDomainsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sslClient, ok := providerData["ssl"].(*ssl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected SSL Client Type",
+			fmt.Sprintf("Expected *ssl.Client, got: %T. Please report this issue to the provider developers.", providerData["ssl"]),
+		)
+		return
+	}
+
+	r.client = sslClient
+}
+
+// setExcludedDomains excludes the planned domains from AutoSSL and includes the other ones
+// again, then starts an AutoSSL check if asked to.
+func (r *autoSSLExcludedDomainsResource) setExcludedDomains(plan AutoSSLExcludedDomainsModel) error {
+	excludedDomains, err := r.client.GetAutoSSLExcludedDomains()
+	if err != nil {
+		return err
+	}
+
+	add, remove := AutoSSLExcludedDomainsChanges(excludedDomains, plan.Domains)
+
+	if len(remove) > 0 {
+		_, err = r.client.RemoveAutoSSLExcludedDomains(ssl.AutoSSLExcludedDomainsUpdateModel{Domains: remove})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(add) > 0 {
+		_, err = r.client.AddAutoSSLExcludedDomains(ssl.AutoSSLExcludedDomainsUpdateModel{Domains: add})
+		if err != nil {
+			return err
+		}
+	}
+
+	if !plan.CheckOnChange.ValueBool() || len(add)+len(remove) == 0 {
+		return nil
+	}
+
+	// A single check can run at a time
+	inProgress, err := r.client.IsAutoSSLCheckInProgress()
+	if err != nil || inProgress {
+		return err
+	}
+
+	_, err = r.client.StartAutoSSLCheck()
+
+	return err
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccAutoSSLExcludedDomainsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_autossl_excluded_domains" "excluded" {
+						domains = ["mail.bolo8774.odns.fr"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_autossl_excluded_domains.excluded", "domains.#", "1"),
+					resource.TestCheckResourceAttrSet("cpanel_autossl_excluded_domains.excluded", "id"),
+					resource.TestCheckResourceAttrSet("cpanel_autossl_excluded_domains.excluded", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cpanel_autossl_excluded_domains.excluded",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestAutoSSLExcludedDomainsResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddParkedDomain("example.org")
+	server.AddSubdomain("cdn", "example.com", "public_html/cdn")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkAutoSSLExcludedDomains(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_autossl_excluded_domains" "excluded" {
+						domains = ["cdn.example.com", "example.org"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_autossl_excluded_domains.excluded", "id", cpaneltest.Username),
+					resource.TestCheckResourceAttr("cpanel_autossl_excluded_domains.excluded", "domains.#", "2"),
+					resource.TestCheckResourceAttr("cpanel_autossl_excluded_domains.excluded", "check_on_change", "false"),
+					resource.TestCheckResourceAttrSet("cpanel_autossl_excluded_domains.excluded", "last_updated"),
+					checkAutoSSLExcludedDomains(server, "cdn.example.com", "example.org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cpanel_autossl_excluded_domains.excluded",
+				ImportStateId:           cpaneltest.Username,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					server.ExcludeAutoSSLDomain("example.com")
+					server.IncludeAutoSSLDomain("example.org")
+				},
+				Config: server.ProviderConfig() + `
+					resource "cpanel_autossl_excluded_domains" "excluded" {
+						domains = ["cdn.example.com", "example.org"]
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing, also reverting the changes made outside Terraform
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_autossl_excluded_domains" "excluded" {
+						domains         = ["cdn.example.com"]
+						check_on_change = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_autossl_excluded_domains.excluded", "domains.#", "1"),
+					checkAutoSSLExcludedDomains(server, "cdn.example.com"),
+					checkAutoSSLCheckStarted(server, 1),
+				),
+			},
+			// No check is started when nothing changes
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_autossl_excluded_domains" "excluded" {
+						domains         = ["cdn.example.com"]
+						check_on_change = true
+					}
+				`,
+				Check: checkAutoSSLCheckStarted(server, 1),
+			},
+			// Unknown domains cannot be excluded
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_autossl_excluded_domains" "excluded" {
+						domains = ["cdn.example.com", "example.net"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`does not\s+exist on this account`),
+			},
+		},
+	})
+}
+
+// checkAutoSSLExcludedDomains checks that the domains are the only ones excluded from AutoSSL.
+func checkAutoSSLExcludedDomains(server *cpaneltest.Server, domains ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		excluded := server.AutoSSLExcludedDomains()
+		if strings.Join(excluded, ",") != strings.Join(domains, ",") {
+			return fmt.Errorf("expected the excluded domains to be %v, got %v", domains, excluded)
+		}
+		return nil
+	}
+}
+
+func checkAutoSSLCheckStarted(server *cpaneltest.Server, count int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		started := 0
+		for _, call := range server.Calls() {
+			if call.Function == "start_autossl_check" {
+				started++
+			}
+		}
+		if started != count {
+			return fmt.Errorf("expected %d AutoSSL checks to be started, got %d", count, started)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &autoSSLStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &autoSSLStatusDataSource{}
+)
+
+// NewAutoSSLStatusDataSource is a helper function to simplify the provider implementation.
+func NewAutoSSLStatusDataSource() datasource.DataSource {
+	return &autoSSLStatusDataSource{}
+}
+
+// autoSSLStatusDataSource is the data source implementation.
+type autoSSLStatusDataSource struct {
+	client *ssl.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *autoSSLStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sslClient, ok := providerData["ssl"].(*ssl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected SSL Client Type",
+			fmt.Sprintf("Expected *ssl.Client, got: %T. Please report this issue to the provider developers.", providerData["ssl"]),
+		)
+		return
+	}
+
+	d.client = sslClient
+}
+
+// Metadata returns the data source type name.
+func (d *autoSSLStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_autossl_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *autoSSLStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Reports the state of AutoSSL on the account, and the problems found by its last check.",
+		MarkdownDescription: "Reports the state of AutoSSL on the account, and the problems found by its last check.",
+		Attributes: map[string]schema.Attribute{
+			"check_in_progress": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether an AutoSSL check is running.",
+				MarkdownDescription: "Whether an AutoSSL check is running.",
+			},
+			"last_run": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The time of the last AutoSSL check, in RFC 3339 format, as reported by its problems. Null when the last check found no problem.",
+				MarkdownDescription: "The time of the last AutoSSL check, in RFC 3339 format, as reported by its problems. Null when the last check found no problem.",
+			},
+			"problems": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The problems found by the last AutoSSL check.",
+				MarkdownDescription: "The problems found by the last AutoSSL check.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The domain AutoSSL could not secure.",
+							MarkdownDescription: "The domain AutoSSL could not secure.",
+						},
+						"problem": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The description of the problem.",
+							MarkdownDescription: "The description of the problem.",
+						},
+						"time": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The time the problem was found, in RFC 3339 format.",
+							MarkdownDescription: "The time the problem was found, in RFC 3339 format.",
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *autoSSLStatusDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	checkInProgress, err := d.client.IsAutoSSLCheckInProgress()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read AutoSSL status: %s", err),
+			err.Error(),
+		)
+		return
+	}
+
+	problems, err := d.client.GetAutoSSLProblems()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read AutoSSL problems: %s", err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := AutoSSLStatusAPIToModel(problems, checkInProgress)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccAutoSSLStatusDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "cpanel_autossl_status" "status" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.cpanel_autossl_status.status", "check_in_progress"),
+					resource.TestCheckResourceAttrSet("data.cpanel_autossl_status.status", "problems.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAutoSSLStatusDataSource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	lastRun := time.Date(2024, 5, 8, 3, 12, 45, 0, time.UTC)
+	server.AddAutoSSLProblem(cpaneltest.AutoSSLProblem{
+		Domain:  "www.example.com",
+		Problem: "The system queried for a temporary file at “http://www.example.com/.well-known/pki-validation/test.txt”, but the web server responded with the following error: 404 (Not Found).",
+		Time:    lastRun.Add(-time.Minute),
+	})
+	server.AddAutoSSLProblem(cpaneltest.AutoSSLProblem{
+		Domain:  "mail.example.com",
+		Problem: "DNS DCV: No local authority: “mail.example.com”",
+		Time:    lastRun,
+	})
+	server.SetAutoSSLCheckInProgress(true)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_autossl_status" "status" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "check_in_progress", "true"),
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "last_run", "2024-05-08T03:12:45Z"),
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "problems.#", "2"),
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "problems.0.domain", "www.example.com"),
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "problems.0.time", "2024-05-08T03:11:45Z"),
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "problems.1.domain", "mail.example.com"),
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "problems.1.problem", "DNS DCV: No local authority: “mail.example.com”"),
+					resource.TestCheckResourceAttrSet("data.cpanel_autossl_status.status", "last_updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAutoSSLStatusDataSourceWithoutProblems(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_autossl_status" "status" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "check_in_progress", "false"),
+					resource.TestCheckNoResourceAttr("data.cpanel_autossl_status.status", "last_run"),
+					resource.TestCheckResourceAttr("data.cpanel_autossl_status.status", "problems.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+	"time"
+)
+
+type AutoSSLStatusDataSourceModel struct {
+	CheckInProgress types.Bool            `tfsdk:"check_in_progress"`
+	LastRun         types.String          `tfsdk:"last_run"`
+	Problems        []AutoSSLProblemModel `tfsdk:"problems"`
+	LastUpdated     types.String          `tfsdk:"last_updated"`
+}
+
+type AutoSSLProblemModel struct {
+	Domain  types.String `tfsdk:"domain"`
+	Problem types.String `tfsdk:"problem"`
+	Time    types.String `tfsdk:"time"`
+}
+
+// AutoSSLStatusAPIToModel builds the status from the problems of the last check, the time
+// of the last check being the time of its latest problem.
+func AutoSSLStatusAPIToModel(problems *ssl.AutoSSLProblemsDataSourceModel, checkInProgress bool) *AutoSSLStatusDataSourceModel {
+	status := &AutoSSLStatusDataSourceModel{
+		CheckInProgress: types.BoolValue(checkInProgress),
+		LastRun:         types.StringNull(),
+		Problems:        make([]AutoSSLProblemModel, 0, len(problems.Data)),
+		LastUpdated:     types.StringValue(time.Now().Format(time.RFC3339)),
+	}
+
+	var lastRun time.Time
+	for _, problem := range problems.Data {
+		problemTime := problem.Time
+		if parsed, err := time.Parse(time.RFC3339, problem.Time); err == nil {
+			problemTime = parsed.UTC().Format(time.RFC3339)
+			if parsed.After(lastRun) {
+				lastRun = parsed
+			}
+		}
+
+		status.Problems = append(status.Problems, AutoSSLProblemModel{
+			Domain:  types.StringValue(problem.Domain),
+			Problem: types.StringValue(problem.Problem),
+			Time:    types.StringValue(problemTime),
+		})
+	}
+
+	if !lastRun.IsZero() {
+		status.LastRun = types.StringValue(lastRun.UTC().Format(time.RFC3339))
+	}
+
+	return status
+}
@@ -3,11 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-cpanel/internal/cpanel/cron"
+	"terraform-provider-cpanel/internal/cronvalidator"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -62,6 +63,11 @@ func (d *cronJobDataSource) Metadata(_ context.Context, req datasource.MetadataR
 func (d *cronJobDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The identifier of the cron job when it is managed by Terraform.",
+				MarkdownDescription: "The identifier of the cron job when it is managed by Terraform.",
+			},
 			"command": schema.StringAttribute{
 				Required:            true,
 				Description:         "The command to run.",
@@ -69,31 +75,59 @@ func (d *cronJobDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 			},
 			"minute": schema.StringAttribute{
 				Required:            true,
-				Description:         "The minute of the hour to run the cron job. Expressions such as */5 or 0,30 are allowed.",
-				MarkdownDescription: "The minute of the hour to run the cron job. Expressions such as */5 or 0,30 are allowed.",
+				Description:         "The minute of the hour to run the cron job, from 0 to 59. Expressions such as */5 or 0,30 are allowed.",
+				MarkdownDescription: "The minute of the hour to run the cron job, from 0 to 59. Expressions such as `*/5` or `0,30` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Minute(),
+				},
 			},
 			"hour": schema.StringAttribute{
 				Required:            true,
-				Description:         "The hour of the day to run the cron job. Expressions such as */2 or 0,12 are allowed.",
-				MarkdownDescription: "The hour of the day to run the cron job. Expressions such as */2 or 0,12 are allowed.",
+				Description:         "The hour of the day to run the cron job, from 0 to 23. Expressions such as */2 or 9-17 are allowed.",
+				MarkdownDescription: "The hour of the day to run the cron job, from 0 to 23. Expressions such as `*/2` or `9-17` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Hour(),
+				},
 			},
 			"day": schema.StringAttribute{
 				Required:            true,
-				Description:         "The day of the month to run the cron job. Expressions such as */15 are allowed.",
-				MarkdownDescription: "The day of the month to run the cron job. Expressions such as */15 are allowed.",
+				Description:         "The day of the month to run the cron job, from 1 to 31. Expressions such as */15 or 1,15 are allowed.",
+				MarkdownDescription: "The day of the month to run the cron job, from 1 to 31. Expressions such as `*/15` or `1,15` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Day(),
+				},
 			},
 			"weekday": schema.StringAttribute{
 				Required:            true,
-				Description:         "The day of the week to run the cron job.",
-				MarkdownDescription: "The day of the week to run the cron job.",
+				Description:         "The day of the week to run the cron job, from 0 to 7 where both 0 and 7 are Sunday, or names such as MON. Expressions such as 1-5 or SAT,SUN are allowed.",
+				MarkdownDescription: "The day of the week to run the cron job, from 0 to 7 where both 0 and 7 are Sunday, or names such as `MON`. Expressions such as `1-5` or `SAT,SUN` are allowed.",
 				Validators: []validator.String{
-					stringvalidator.OneOf("0", "1", "2", "3", "4", "5", "6", "7", "*"),
+					cronvalidator.Weekday(),
 				},
 			},
 			"month": schema.StringAttribute{
 				Required:            true,
-				Description:         "The month of the year to run the cron job. Expressions such as */3 or 1,4,7 are allowed.",
-				MarkdownDescription: "The month of the year to run the cron job. Expressions such as */3 or 1,4,7 are allowed.",
+				Description:         "The month of the year to run the cron job, from 1 to 12 or names such as JAN. Expressions such as */3 or 1,4,7 are allowed.",
+				MarkdownDescription: "The month of the year to run the cron job, from 1 to 12 or names such as `JAN`. Expressions such as `*/3` or `1,4,7` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Month(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The schedule of the cron job, such as */5 * * * *.",
+				MarkdownDescription: "The schedule of the cron job, such as `*/5 * * * *`.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The schedule in plain English, such as At 02:30 on Monday.",
+				MarkdownDescription: "The schedule in plain English, such as `At 02:30 on Monday`.",
+			},
+			"next_runs": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The next 5 run times, in UTC and RFC 3339 format. They are computed by the provider, which does not know the time zone of the cPanel server.",
+				MarkdownDescription: "The next 5 run times, in UTC and RFC 3339 format. They are computed by the provider, which does not know the time zone of the cPanel server.",
 			},
 			"linekey": schema.Int64Attribute{
 				Computed:            true,
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
 )
 
 func TestAccCronJobDataSource(t *testing.T) {
@@ -34,3 +36,35 @@ func TestAccCronJobDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestCronJobDataSource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddCronJob(cpaneltest.CronJob{Command: "ls -la", Minute: "0", Hour: "0", Day: "1", Month: "1", Weekday: "*"})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_cron_job" "cron_read" {
+					command = "ls -la"
+					minute = "0"
+					hour = "0"
+					day = "1"
+					weekday = "*"
+					month = "1"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_cron_job.cron_read", "command", "ls -la"),
+					resource.TestCheckResourceAttr("data.cpanel_cron_job.cron_read", "linekey", "1"),
+					resource.TestCheckResourceAttr("data.cpanel_cron_job.cron_read", "schedule", "0 0 1 1 *"),
+					resource.TestCheckResourceAttr("data.cpanel_cron_job.cron_read", "description", "At 00:00 on day 1 of the month in January"),
+					resource.TestCheckResourceAttr("data.cpanel_cron_job.cron_read", "next_runs.#", "5"),
+					resource.TestMatchResourceAttr("data.cpanel_cron_job.cron_read", "next_runs.0", regexp.MustCompile(`^\d{4}-01-01T00:00:00Z$`)),
+					resource.TestCheckResourceAttrSet("data.cpanel_cron_job.cron_read", "last_updated"),
+				),
+			},
+		},
+	})
+}
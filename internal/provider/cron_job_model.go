@@ -3,12 +3,18 @@ package provider
 import (
 	"crypto/md5"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-cpanel/internal/cpanel/cron"
+	"terraform-provider-cpanel/internal/cronexpr"
 	"time"
 )
 
+// cronJobNextRunsCount is the number of run times computed for the next_runs attribute.
+const cronJobNextRunsCount = 5
+
 type CronJobModel struct {
+	ID          types.String `tfsdk:"id"`
 	LineKey     types.Int64  `tfsdk:"linekey"`
 	Weekday     types.String `tfsdk:"weekday"`
 	Minute      types.String `tfsdk:"minute"`
@@ -16,6 +22,9 @@ type CronJobModel struct {
 	Day         types.String `tfsdk:"day"`
 	Month       types.String `tfsdk:"month"`
 	Command     types.String `tfsdk:"command"`
+	Schedule    types.String `tfsdk:"schedule"`
+	Description types.String `tfsdk:"description"`
+	NextRuns    types.List   `tfsdk:"next_runs"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 }
 
@@ -25,29 +34,89 @@ func CronJobAPIToModel(cronJobDataSourceModel *cron.CronJobDataSourceModel, inte
 			continue
 		}
 
-		return &CronJobModel{
-			LineKey:     types.Int64Value(data.LineKey),
-			Weekday:     types.StringValue(data.Weekday),
-			Minute:      types.StringValue(data.Minute),
-			Hour:        types.StringValue(data.Hour),
-			Day:         types.StringValue(data.Day),
-			Month:       types.StringValue(data.Month),
-			Command:     types.StringValue(data.Command),
-			LastUpdated: types.StringValue(time.Now().Format(time.RFC3339)),
-		}
+		return CronJobDataAPIToModel(data)
 	}
 
 	return nil
 }
 
+// CronJobDataAPIToModel converts a crontab line, the identifying comment being removed from its command.
+func CronJobDataAPIToModel(data cron.CronJobDataSourceDataModel) *CronJobModel {
+	command, id := cron.SplitCommand(data.Command)
+
+	cronJobModel := &CronJobModel{
+		ID:          optionalStringValue(id),
+		LineKey:     types.Int64Value(data.LineKey),
+		Weekday:     types.StringValue(data.Weekday),
+		Minute:      types.StringValue(data.Minute),
+		Hour:        types.StringValue(data.Hour),
+		Day:         types.StringValue(data.Day),
+		Month:       types.StringValue(data.Month),
+		Command:     types.StringValue(command),
+		Schedule:    types.StringValue(cronJobDataSchedule(data).String()),
+		LastUpdated: types.StringValue(time.Now().Format(time.RFC3339)),
+	}
+	cronJobModel.setRuns(time.Now())
+
+	return cronJobModel
+}
+
+// setRuns sets the description and the next run times of the schedule, which are null when the
+// schedule could not be parsed.
+func (m *CronJobModel) setRuns(now time.Time) {
+	m.Description = types.StringNull()
+	m.NextRuns = types.ListNull(types.StringType)
+
+	schedule := m.schedule()
+	description, err := schedule.Describe()
+	if err != nil {
+		return
+	}
+
+	runs, err := schedule.Next(now.UTC(), cronJobNextRunsCount)
+	if err != nil {
+		return
+	}
+
+	nextRuns := make([]attr.Value, 0, len(runs))
+	for _, run := range runs {
+		nextRuns = append(nextRuns, types.StringValue(run.Format(time.RFC3339)))
+	}
+
+	m.Description = types.StringValue(description)
+	m.NextRuns = types.ListValueMust(types.StringType, nextRuns)
+}
+
+// cronJobScheduleValue returns the schedule of the cron job, keeping the prior schedule when it
+// stands for the same fields, such as @daily.
+func cronJobScheduleValue(prior types.String, cronJobModel *CronJobModel) types.String {
+	if schedule, err := cronexpr.ParseSchedule(prior.ValueString()); err == nil && schedule == cronJobModel.schedule() {
+		return prior
+	}
+
+	return cronJobModel.Schedule
+}
+
+func (m *CronJobModel) schedule() cronexpr.Schedule {
+	return cronexpr.Schedule{
+		Minute:  m.Minute.ValueString(),
+		Hour:    m.Hour.ValueString(),
+		Day:     m.Day.ValueString(),
+		Month:   m.Month.ValueString(),
+		Weekday: m.Weekday.ValueString(),
+	}
+}
+
 func CalculateCronJobDataSourceDataModelInternalId(cronJobDataSourceDataModel cron.CronJobDataSourceDataModel) string {
+	command, _ := cron.SplitCommand(cronJobDataSourceDataModel.Command)
+
 	return calculateInternalId(
 		cronJobDataSourceDataModel.Minute,
 		cronJobDataSourceDataModel.Hour,
 		cronJobDataSourceDataModel.Day,
 		cronJobDataSourceDataModel.Weekday,
 		cronJobDataSourceDataModel.Month,
-		cronJobDataSourceDataModel.Command,
+		command,
 	)
 }
 
@@ -75,3 +144,13 @@ func calculateInternalId(minute, hour, day, weekday, month, command string) stri
 
 	return fmt.Sprintf("%x", hash)
 }
+
+func cronJobDataSchedule(data cron.CronJobDataSourceDataModel) cronexpr.Schedule {
+	return cronexpr.Schedule{
+		Minute:  data.Minute,
+		Hour:    data.Hour,
+		Day:     data.Day,
+		Month:   data.Month,
+		Weekday: data.Weekday,
+	}
+}
@@ -2,22 +2,39 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
 	"terraform-provider-cpanel/internal/cpanel/cron"
+	"terraform-provider-cpanel/internal/cronexpr"
+	"terraform-provider-cpanel/internal/cronvalidator"
 	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &cronJobResource{}
-	_ resource.ResourceWithConfigure = &cronJobResource{}
+	_ resource.Resource                     = &cronJobResource{}
+	_ resource.ResourceWithConfigure        = &cronJobResource{}
+	_ resource.ResourceWithImportState      = &cronJobResource{}
+	_ resource.ResourceWithConfigValidators = &cronJobResource{}
+	_ resource.ResourceWithModifyPlan       = &cronJobResource{}
 )
 
+// crontabLinePattern matches a crontab line, such as "*/5 * * * * /usr/bin/php job.php".
+var crontabLinePattern = regexp.MustCompile(`^\s*(\S+)\s+(\S+)\s+(\S+)\s+(\S+)\s+(\S+)\s+(.*\S)\s*$`)
+
 // NewCronJobResource is a helper function to simplify the provider implementation.
 func NewCronJobResource() resource.Resource {
 	return &cronJobResource{}
@@ -37,38 +54,83 @@ func (r *cronJobResource) Metadata(_ context.Context, req resource.MetadataReque
 func (r *cronJobResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The identifier of the cron job, kept in a # tf:<id> comment at the end of its crontab line so that it is still found after being edited outside Terraform.",
+				MarkdownDescription: "The identifier of the cron job, kept in a `# tf:<id>` comment at the end of its crontab line so that it is still found after being edited outside Terraform.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"command": schema.StringAttribute{
 				Required:            true,
 				Description:         "The command to run.",
 				MarkdownDescription: "The command to run.",
 			},
+			"schedule": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The schedule of the cron job, such as */5 * * * * or a macro such as @daily. Either the schedule or the minute, hour, day, month and weekday must be set.",
+				MarkdownDescription: "The schedule of the cron job, such as `*/5 * * * *` or a macro such as `@daily`. Either the schedule or the `minute`, `hour`, `day`, `month` and `weekday` must be set.",
+				Validators: []validator.String{
+					cronvalidator.Schedule(),
+				},
+			},
 			"minute": schema.StringAttribute{
-				Required:            true,
-				Description:         "The minute of the hour to run the cron job. Expressions such as */5 or 0,30 are allowed.",
-				MarkdownDescription: "The minute of the hour to run the cron job. Expressions such as */5 or 0,30 are allowed.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The minute of the hour to run the cron job, from 0 to 59. Expressions such as */5 or 0,30 are allowed.",
+				MarkdownDescription: "The minute of the hour to run the cron job, from 0 to 59. Expressions such as `*/5` or `0,30` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Minute(),
+				},
 			},
 			"hour": schema.StringAttribute{
-				Required:            true,
-				Description:         "The hour of the day to run the cron job. Expressions such as */2 or 0,12 are allowed.",
-				MarkdownDescription: "The hour of the day to run the cron job. Expressions such as */2 or 0,12 are allowed.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The hour of the day to run the cron job, from 0 to 23. Expressions such as */2 or 9-17 are allowed.",
+				MarkdownDescription: "The hour of the day to run the cron job, from 0 to 23. Expressions such as `*/2` or `9-17` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Hour(),
+				},
 			},
 			"day": schema.StringAttribute{
-				Required:            true,
-				Description:         "The day of the month to run the cron job. Expressions such as */15 are allowed.",
-				MarkdownDescription: "The day of the month to run the cron job. Expressions such as */15 are allowed.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The day of the month to run the cron job, from 1 to 31. Expressions such as */15 or 1,15 are allowed.",
+				MarkdownDescription: "The day of the month to run the cron job, from 1 to 31. Expressions such as `*/15` or `1,15` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Day(),
+				},
 			},
 			"weekday": schema.StringAttribute{
-				Required:            true,
-				Description:         "The day of the week to run the cron job.",
-				MarkdownDescription: "The day of the week to run the cron job.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The day of the week to run the cron job, from 0 to 7 where both 0 and 7 are Sunday, or names such as MON. Expressions such as 1-5 or SAT,SUN are allowed.",
+				MarkdownDescription: "The day of the week to run the cron job, from 0 to 7 where both 0 and 7 are Sunday, or names such as `MON`. Expressions such as `1-5` or `SAT,SUN` are allowed.",
 				Validators: []validator.String{
-					stringvalidator.OneOf("0", "1", "2", "3", "4", "5", "6", "7", "*"),
+					cronvalidator.Weekday(),
 				},
 			},
 			"month": schema.StringAttribute{
-				Required:            true,
-				Description:         "The month of the year to run the cron job. Expressions such as */3 or 1,4,7 are allowed.",
-				MarkdownDescription: "The month of the year to run the cron job. Expressions such as */3 or 1,4,7 are allowed.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The month of the year to run the cron job, from 1 to 12 or names such as JAN. Expressions such as */3 or 1,4,7 are allowed.",
+				MarkdownDescription: "The month of the year to run the cron job, from 1 to 12 or names such as `JAN`. Expressions such as `*/3` or `1,4,7` are allowed.",
+				Validators: []validator.String{
+					cronvalidator.Month(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The schedule in plain English, such as At 02:30 on Monday.",
+				MarkdownDescription: "The schedule in plain English, such as `At 02:30 on Monday`.",
+			},
+			"next_runs": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The next 5 run times, in UTC and RFC 3339 format. They are computed by the provider, which does not know the time zone of the cPanel server.",
+				MarkdownDescription: "The next 5 run times, in UTC and RFC 3339 format. They are computed by the provider, which does not know the time zone of the cPanel server.",
 			},
 			"linekey": schema.Int64Attribute{
 				Computed:            true,
@@ -82,6 +144,91 @@ func (r *cronJobResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+// ConfigValidators ensures that either the schedule or the five fields are set.
+func (r *cronJobResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("schedule"),
+			path.MatchRoot("minute"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("minute"),
+			path.MatchRoot("hour"),
+			path.MatchRoot("day"),
+			path.MatchRoot("month"),
+			path.MatchRoot("weekday"),
+		),
+	}
+}
+
+// ModifyPlan computes the five fields from the configured schedule, or the schedule from the configured fields,
+// and the description of the schedule.
+func (r *cronJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan CronJobModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case config.Schedule.IsUnknown():
+		plan.Minute = types.StringUnknown()
+		plan.Hour = types.StringUnknown()
+		plan.Day = types.StringUnknown()
+		plan.Month = types.StringUnknown()
+		plan.Weekday = types.StringUnknown()
+	case !config.Schedule.IsNull():
+		schedule, err := cronexpr.ParseSchedule(config.Schedule.ValueString())
+		if err != nil {
+			// Reported by the schedule validator
+			return
+		}
+
+		plan.Minute = types.StringValue(schedule.Minute)
+		plan.Hour = types.StringValue(schedule.Hour)
+		plan.Day = types.StringValue(schedule.Day)
+		plan.Month = types.StringValue(schedule.Month)
+		plan.Weekday = types.StringValue(schedule.Weekday)
+	case config.Minute.IsUnknown() || config.Hour.IsUnknown() || config.Day.IsUnknown() || config.Month.IsUnknown() || config.Weekday.IsUnknown():
+		plan.Schedule = types.StringUnknown()
+	default:
+		plan.Schedule = types.StringValue(config.schedule().String())
+	}
+
+	// The description only depends on the schedule, unlike the next run times computed on apply
+	plan.Description = types.StringUnknown()
+	if !plan.Minute.IsUnknown() && !plan.Hour.IsUnknown() && !plan.Day.IsUnknown() && !plan.Month.IsUnknown() && !plan.Weekday.IsUnknown() {
+		if description, err := plan.schedule().Describe(); err == nil {
+			plan.Description = types.StringValue(description)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The framework only marks the attributes computed on apply as unknown when the configuration
+	// changes, not when the plan is modified here
+	if !req.State.Raw.IsNull() && !resp.Plan.Raw.Equal(req.State.Raw) {
+		plan.LineKey = types.Int64Unknown()
+		plan.NextRuns = types.ListUnknown(types.StringType)
+		plan.LastUpdated = types.StringUnknown()
+		if plan.ID.IsNull() {
+			plan.ID = types.StringUnknown()
+		}
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *cronJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Retrieve values from plan
@@ -94,7 +241,7 @@ func (r *cronJobResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Read crons
-	cronJobDataSource, err := r.client.GetCronJobs()
+	cronJob, err := r.findCronJob(plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting crons",
@@ -103,7 +250,14 @@ func (r *cronJobResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	state := CronJobAPIToModel(cronJobDataSource, CalculateCronJobModelInternalId(plan))
+	// Remove the cron job from the state if it has been deleted outside Terraform
+	if cronJob == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state := CronJobDataAPIToModel(*cronJob)
+	state.Schedule = cronJobScheduleValue(plan.Schedule, state)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -124,9 +278,18 @@ func (r *cronJobResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cron job",
+			"Could not generate cron job identifier, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
 	// Generate API request parameters from plan
 	var cronJob cron.CronJobCreateModel
-	cronJob.Command = plan.Command.ValueString()
+	cronJob.Command = cron.MarkCommand(plan.Command.ValueString(), id)
 	cronJob.Minute = plan.Minute.ValueString()
 	cronJob.Hour = plan.Hour.ValueString()
 	cronJob.Day = plan.Day.ValueString()
@@ -153,15 +316,9 @@ func (r *cronJobResource) Create(ctx context.Context, req resource.CreateRequest
 
 	cronJobData := cronJobDataSourceModel.CpanelResult.Data[0]
 
-	if cronJobData.Status != 1 {
-		resp.Diagnostics.AddError(
-			"Error creating cron",
-			fmt.Sprintf("Could not create cron, got error: %+v", cronJobData.StatusMsg),
-		)
-		return
-	}
-
+	plan.ID = types.StringValue(id)
 	plan.LineKey = types.Int64Value(cronJobData.LineKey)
+	plan.setRuns(time.Now())
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	// Set state to fully populated data
@@ -191,10 +348,37 @@ func (r *cronJobResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Find the current line of the cron job, as line keys shift when other lines are removed
+	currentCronJob, err := r.findCronJob(state)
+	if err == nil && currentCronJob == nil {
+		err = errors.New("the cron job no longer exists")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating cron job",
+			"Could not find cron job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Cron jobs created before they were identified are tagged on their first update
+	id := state.ID.ValueString()
+	if id == "" {
+		id, err = uuid.GenerateUUID()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating cron job",
+				"Could not generate cron job identifier, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Generate API request parameters from plan
 	var cronJob cron.CronJobUpdateModel
-	cronJob.LineKey = state.LineKey.ValueInt64()
-	cronJob.Command = plan.Command.ValueString()
+	cronJob.LineKey = currentCronJob.LineKey
+	cronJob.Command = cron.MarkCommand(plan.Command.ValueString(), id)
 	cronJob.Minute = plan.Minute.ValueString()
 	cronJob.Hour = plan.Hour.ValueString()
 	cronJob.Day = plan.Day.ValueString()
@@ -210,18 +394,19 @@ func (r *cronJobResource) Update(ctx context.Context, req resource.UpdateRequest
 		)
 		return
 	}
-
-	cronJobData := cronJobDataSourceModel.CpanelResult.Data[0]
-
-	if cronJobData.Status != 1 {
+	if len(cronJobDataSourceModel.CpanelResult.Data) != 1 {
 		resp.Diagnostics.AddError(
 			"Error updating cron job",
-			fmt.Sprintf("Could not update cron job, got error: %+v", cronJobData.StatusMsg),
+			fmt.Sprintf("Could not update cron job, got unexpected errors: %+v", cronJobDataSourceModel),
 		)
 		return
 	}
 
+	cronJobData := cronJobDataSourceModel.CpanelResult.Data[0]
+
+	plan.ID = types.StringValue(id)
 	plan.LineKey = types.Int64Value(cronJobData.LineKey)
+	plan.setRuns(time.Now())
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	// Set state to fully populated data
@@ -242,29 +427,65 @@ func (r *cronJobResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	// Find the current line of the cron job, as line keys shift when other lines are removed
+	currentCronJob, err := r.findCronJob(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting cron job",
+			"Could not find cron job, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if currentCronJob == nil {
+		return
+	}
+
 	var cronJob cron.CronJobDeleteModel
-	cronJob.LineKey = state.LineKey.ValueInt64()
+	cronJob.LineKey = currentCronJob.LineKey
 
-	// Delete existing user
-	cronJobDataSourceModel, err := r.client.DeleteCronJob(cronJob)
+	// Delete existing cron job
+	_, err = r.client.DeleteCronJob(cronJob)
 
-	if err != nil {
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleting cron job",
 			"Could not deleting cron job, unexpected error: "+err.Error(),
 		)
 		return
 	}
+}
 
-	cronJobData := cronJobDataSourceModel.CpanelResult.Data[0]
+// ImportState imports the cron job from its line key, "commandnumber:<number>" or its full crontab line.
+// Importing leaves the crontab unchanged, the line being tagged with an identifier on its first update.
+func (r *cronJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	cronJobs, err := r.client.GetCronJobs()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting crons",
+			"Could not get crons, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	cronJob, err := findImportedCronJob(cronJobs, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: linekey, linekey:<linekey>, commandnumber:<commandnumber> or a crontab line such as \"*/5 * * * * command\". Got: %q", req.ID),
+		)
+		return
+	}
 
-	if cronJobData.Status != 1 {
+	if cronJob == nil {
 		resp.Diagnostics.AddError(
-			"Error deleting user",
-			fmt.Sprintf("Could not create cron, got error: %+v", cronJobData.StatusMsg),
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an existing cron job. Got: %q", req.ID),
 		)
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, CronJobDataAPIToModel(*cronJob))...)
 }
 
 // Configure adds the provider configured client to the resource.
@@ -293,3 +514,55 @@ func (r *cronJobResource) Configure(_ context.Context, req resource.ConfigureReq
 
 	r.client = cronClient
 }
+
+// findCronJob returns the crontab line of the cron job, or nil if it does not exist. Cron jobs created
+// before they were identified are found from their schedule and command.
+func (r *cronJobResource) findCronJob(state CronJobModel) (*cron.CronJobDataSourceDataModel, error) {
+	cronJobs, err := r.client.GetCronJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	if !state.ID.IsNull() && state.ID.ValueString() != "" {
+		return cronJobs.FindByID(state.ID.ValueString()), nil
+	}
+
+	internalId := CalculateCronJobModelInternalId(state)
+	for _, data := range cronJobs.CpanelResult.Data {
+		if CalculateCronJobDataSourceDataModelInternalId(data) == internalId {
+			return &data, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findImportedCronJob returns the crontab line designated by the import identifier, or nil if it does not exist.
+func findImportedCronJob(cronJobs *cron.CronJobDataSourceModel, id string) (*cron.CronJobDataSourceDataModel, error) {
+	if value, found := strings.CutPrefix(id, "commandnumber:"); found {
+		commandNumber, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		return cronJobs.FindByCommandNumber(commandNumber), nil
+	}
+
+	if lineKey, err := strconv.ParseInt(strings.TrimPrefix(id, "linekey:"), 10, 64); err == nil {
+		return cronJobs.FindByLineKey(lineKey), nil
+	}
+
+	match := crontabLinePattern.FindStringSubmatch(id)
+	if match == nil {
+		return nil, errors.New("invalid crontab line")
+	}
+
+	for _, data := range cronJobs.CpanelResult.Data {
+		command, _ := cron.SplitCommand(data.Command)
+		if data.Type == "command" && data.Minute == match[1] && data.Hour == match[2] && data.Day == match[3] && data.Month == match[4] && data.Weekday == match[5] && strings.TrimSpace(command) == match[6] {
+			return &data, nil
+		}
+	}
+
+	return nil, nil
+}
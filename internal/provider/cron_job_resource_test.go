@@ -1,9 +1,18 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/cron"
 )
 
 func TestAccCronJobResource(t *testing.T) {
@@ -55,3 +64,504 @@ func TestAccCronJobResource(t *testing.T) {
 		},
 	})
 }
+
+func TestCronJobResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `resource "cpanel_cron_job" "cron" {
+						command = "echo 'create'"
+						minute = "0"
+						hour = "0"
+						day = "1"
+						weekday = "*"
+						month = "1"
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "command", "echo 'create'"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "linekey", "1"),
+					resource.TestCheckResourceAttrSet("cpanel_cron_job.cron", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cpanel_cron_job.cron",
+				ImportStateId:           "1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: server.ProviderConfig() + `resource "cpanel_cron_job" "cron" {
+						command = "ls -lar"
+						minute = "1"
+						hour = "1"
+						day = "2"
+						weekday = "3"
+						month = "2"
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "command", "ls -lar"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "weekday", "3"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "linekey", "1"),
+					func(_ *terraform.State) error {
+						if jobs := server.CronJobs(); len(jobs) != 1 || !strings.HasPrefix(jobs[0].Command, "ls -lar # tf:") {
+							return fmt.Errorf("expected the cron job to be updated in place, got: %+v", jobs)
+						}
+						return nil
+					},
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.RemoveCronLine(1) },
+				Config: server.ProviderConfig() + `resource "cpanel_cron_job" "cron" {
+						command = "ls -lar"
+						minute = "1"
+						hour = "1"
+						day = "2"
+						weekday = "3"
+						month = "2"
+					}`,
+				Check: func(_ *terraform.State) error {
+					if jobs := server.CronJobs(); len(jobs) != 1 {
+						return fmt.Errorf("expected the cron job to be re-created, got: %+v", jobs)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestCronJobResourceIdentity(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	config := server.ProviderConfig() + `
+		resource "cpanel_cron_job" "backup" {
+			command = "/usr/local/bin/backup.sh"
+			minute = "30"
+			hour = "2"
+			day = "*"
+			weekday = "*"
+			month = "*"
+		}
+
+		resource "cpanel_cron_job" "cleanup" {
+			command = "/usr/local/bin/cleanup.sh"
+			minute = "0"
+			hour = "4"
+			day = "*"
+			weekday = "*"
+			month = "*"
+
+			# Keeps the backup on the first line
+			depends_on = [cpanel_cron_job.backup]
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the crontab lines being tagged with the identifiers
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_job.backup", "command", "/usr/local/bin/backup.sh"),
+					resource.TestMatchResourceAttr("cpanel_cron_job.backup", "id", regexp.MustCompile(`^[0-9a-f-]{36}$`)),
+					checkCronJobTagged(server, "cpanel_cron_job.backup", "/usr/local/bin/backup.sh", "2"),
+					checkCronJobTagged(server, "cpanel_cron_job.cleanup", "/usr/local/bin/cleanup.sh", "4"),
+				),
+			},
+			// Drift testing, after the schedule and the command have been edited outside Terraform
+			{
+				PreConfig: func() {
+					job := cronJobWithCommandPrefix(server, "/usr/local/bin/backup.sh")
+					job.Hour = "3"
+					job.Command = strings.Replace(job.Command, "backup.sh", "backup.sh --full", 1)
+					server.EditCronJob(job.LineKey, job)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing, the edited cron job being restored in place
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkCronJobTagged(server, "cpanel_cron_job.backup", "/usr/local/bin/backup.sh", "2"),
+					func(_ *terraform.State) error {
+						if jobs := server.CronJobs(); len(jobs) != 2 {
+							return fmt.Errorf("expected the cron job to be updated in place, got: %+v", jobs)
+						}
+						return nil
+					},
+				),
+			},
+			// Re-create after deletion outside Terraform, the line key of the other cron job shifting
+			{
+				PreConfig: func() { server.RemoveCronLine(cronJobWithCommandPrefix(server, "/usr/local/bin/backup.sh").LineKey) },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkCronJobTagged(server, "cpanel_cron_job.backup", "/usr/local/bin/backup.sh", "2"),
+					checkCronJobTagged(server, "cpanel_cron_job.cleanup", "/usr/local/bin/cleanup.sh", "4"),
+				),
+			},
+			// Delete testing, the line keys shifting as the cron jobs are removed
+			{
+				Config: server.ProviderConfig(),
+				Check: func(_ *terraform.State) error {
+					if jobs := server.CronJobs(); len(jobs) != 0 {
+						return fmt.Errorf("expected the cron jobs to be deleted, got: %+v", jobs)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestCronJobResourceSchedule(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, from a macro
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "/usr/local/bin/backup.sh"
+						schedule = "@daily"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "schedule", "@daily"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "minute", "0"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "hour", "0"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "day", "*"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "month", "*"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "weekday", "*"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "description", "At 00:00"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "next_runs.#", "5"),
+					resource.TestMatchResourceAttr("cpanel_cron_job.cron", "next_runs.0", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T00:00:00Z$`)),
+					checkCronJobSchedule(server, "0 0 * * *"),
+				),
+			},
+			// Update testing, from five fields
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "/usr/local/bin/backup.sh"
+						schedule = "*/5  9-17 * * MON-FRI"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "schedule", "*/5  9-17 * * MON-FRI"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "weekday", "MON-FRI"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "description", "Every 5 minutes, during hours 9 through 17 on Monday through Friday"),
+					checkCronJobSchedule(server, "*/5 9-17 * * MON-FRI"),
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					job := server.CronJobs()[0]
+					job.Weekday = "1-5"
+					server.EditCronJob(job.LineKey, job)
+				},
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "/usr/local/bin/backup.sh"
+						schedule = "*/5  9-17 * * MON-FRI"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing, back to the separate fields
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "/usr/local/bin/backup.sh"
+						minute = "30"
+						hour = "2"
+						day = "1,15"
+						month = "*"
+						weekday = "*"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "schedule", "30 2 1,15 * *"),
+					resource.TestCheckResourceAttr("cpanel_cron_job.cron", "description", "At 02:30 on days 1 and 15 of the month"),
+					resource.TestMatchResourceAttr("cpanel_cron_job.cron", "next_runs.0", regexp.MustCompile(`^\d{4}-\d{2}-(01|15)T02:30:00Z$`)),
+					checkCronJobSchedule(server, "30 2 1,15 * *"),
+				),
+			},
+		},
+	})
+}
+
+func TestCronJobResourceValidation(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "ls"
+						minute = "60"
+						hour = "*"
+						day = "*"
+						month = "*"
+						weekday = "*"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`minute 60 is out of\s+range\s+0-59`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "ls"
+						minute = "0"
+						hour = "*"
+						day = "*"
+						month = "*"
+						weekday = "1-8"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`day of week 8 is\s+out\s+of\s+range\s+0-7`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "ls"
+						schedule = "@reboot"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`unsupported\s+macro\s+"@reboot"`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "ls"
+						schedule = "@daily"
+						minute = "0"
+						hour = "0"
+						day = "*"
+						month = "*"
+						weekday = "*"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "ls"
+						minute = "0"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_job" "cron" {
+						command = "ls"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Exactly one of these attributes must be configured`),
+			},
+		},
+	})
+}
+
+func TestCronJobResourceImport(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddCronJob(cpaneltest.CronJob{Command: "/usr/local/bin/backup.sh", Minute: "30", Hour: "2", Day: "*", Month: "*", Weekday: "*"})
+	server.AddCronJob(cpaneltest.CronJob{Command: "/usr/bin/php  job.php", Minute: "*/5", Hour: "*", Day: "*", Month: "*", Weekday: "*"})
+	server.AddCronJob(cpaneltest.CronJob{Command: "/usr/local/bin/cleanup.sh", Minute: "0", Hour: "4", Day: "*", Month: "*", Weekday: "0"})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Import block testing, from the full crontab line
+			{
+				Config: server.ProviderConfig() + `
+					import {
+						to = cpanel_cron_job.job
+						id = "*/5 * * * * /usr/bin/php  job.php"
+					}
+
+					resource "cpanel_cron_job" "job" {
+						command = "/usr/bin/php  job.php"
+						minute = "*/5"
+						hour = "*"
+						day = "*"
+						weekday = "*"
+						month = "*"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_job.job", "linekey", "2"),
+					resource.TestCheckNoResourceAttr("cpanel_cron_job.job", "id"),
+					func(_ *terraform.State) error {
+						if jobs := server.CronJobs(); len(jobs) != 3 || jobs[1].Command != "/usr/bin/php  job.php" {
+							return fmt.Errorf("expected the cron job to be imported unchanged, got: %+v", jobs)
+						}
+						return checkCronLinesNotEdited(server)
+					},
+				),
+			},
+			// ImportState testing, from the line key
+			{
+				ResourceName:  "cpanel_cron_job.job",
+				ImportStateId: "linekey:3",
+				ImportState:   true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["command"] != "/usr/local/bin/cleanup.sh" || states[0].Attributes["weekday"] != "0" {
+						return fmt.Errorf("expected the third line to be imported, got %v", states)
+					}
+					return checkCronLinesNotEdited(server)
+				},
+			},
+			// ImportState testing, from the command number
+			{
+				ResourceName:  "cpanel_cron_job.job",
+				ImportStateId: "commandnumber:1",
+				ImportState:   true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["command"] != "/usr/local/bin/backup.sh" || states[0].Attributes["linekey"] != "1" {
+						return fmt.Errorf("expected the first command to be imported, got %v", states)
+					}
+					return checkCronLinesNotEdited(server)
+				},
+			},
+			// ImportState testing, with a crontab line matching no cron job
+			{
+				ResourceName:  "cpanel_cron_job.job",
+				ImportStateId: "0 3 * * * /usr/local/bin/backup.sh",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Expected an existing cron job`),
+			},
+			// ImportState testing, with an invalid identifier
+			{
+				ResourceName:  "cpanel_cron_job.job",
+				ImportStateId: "commandnumber:first",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier`),
+			},
+		},
+	})
+}
+
+func TestCronJobResourceReadFindsUntaggedCronJob(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddCronJob(cpaneltest.CronJob{Command: "ls -la", Minute: "0", Hour: "0", Day: "1", Month: "1", Weekday: "*"})
+
+	r := &cronJobResource{client: cron.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &CronJobModel{
+		ID:          types.StringNull(),
+		LineKey:     types.Int64Value(1),
+		Command:     types.StringValue("ls -la"),
+		Minute:      types.StringValue("0"),
+		Hour:        types.StringValue("0"),
+		Day:         types.StringValue("1"),
+		Weekday:     types.StringValue("*"),
+		Month:       types.StringValue("1"),
+		NextRuns:    types.ListNull(types.StringType),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	var model CronJobModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("unexpected error reading the state: %v", diags)
+	}
+	if model.Command.ValueString() != "ls -la" || !model.ID.IsNull() {
+		t.Fatalf("expected the cron job created before it was identified to be found, got: %+v", model)
+	}
+}
+
+func TestCronJobResourceReadRemovesDeletedCronJob(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddCronJob(cpaneltest.CronJob{Command: "ls -la", Minute: "0", Hour: "0", Day: "1", Month: "1", Weekday: "*"})
+
+	r := &cronJobResource{client: cron.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &CronJobModel{
+		ID:          types.StringValue("5e7c2a3b-1b3f-4c8e-9d7a-2f6b8c1d0e9a"),
+		LineKey:     types.Int64Value(2),
+		Command:     types.StringValue("echo 'deleted'"),
+		Minute:      types.StringValue("0"),
+		Hour:        types.StringValue("0"),
+		Day:         types.StringValue("1"),
+		Weekday:     types.StringValue("*"),
+		Month:       types.StringValue("1"),
+		NextRuns:    types.ListNull(types.StringType),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted cron job to be removed from the state")
+	}
+}
+
+func checkCronJobTagged(server *cpaneltest.Server, resourceName, expectedCommand, expectedHour string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		attributes := s.RootModule().Resources[resourceName].Primary.Attributes
+		for _, job := range server.CronJobs() {
+			if job.Command == expectedCommand+" # tf:"+attributes["id"] {
+				if job.Hour != expectedHour {
+					return fmt.Errorf("expected the hour of %s to be %q, got %q", resourceName, expectedHour, job.Hour)
+				}
+				if attributes["linekey"] != strconv.FormatInt(job.LineKey, 10) {
+					return fmt.Errorf("expected the line key of %s to be %d, got %s", resourceName, job.LineKey, attributes["linekey"])
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("expected a crontab line tagged with the identifier of %s, got: %+v", resourceName, server.CronJobs())
+	}
+}
+
+// checkCronLinesNotEdited checks that no crontab line was edited, importing cron jobs being read-only.
+func checkCronLinesNotEdited(server *cpaneltest.Server) error {
+	for _, call := range server.Calls() {
+		if call.Function == "edit_line" {
+			return fmt.Errorf("expected no crontab line to be edited, got: %+v", call)
+		}
+	}
+	return nil
+}
+
+func cronJobWithCommandPrefix(server *cpaneltest.Server, prefix string) cpaneltest.CronJob {
+	for _, job := range server.CronJobs() {
+		if strings.HasPrefix(job.Command, prefix) {
+			return job
+		}
+	}
+	return cpaneltest.CronJob{}
+}
+
+func checkCronJobSchedule(server *cpaneltest.Server, expectedSchedule string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		jobs := server.CronJobs()
+		if len(jobs) != 1 {
+			return fmt.Errorf("expected a single cron job, got: %+v", jobs)
+		}
+		if schedule := strings.Join([]string{jobs[0].Minute, jobs[0].Hour, jobs[0].Day, jobs[0].Month, jobs[0].Weekday}, " "); schedule != expectedSchedule {
+			return fmt.Errorf("expected the schedule of the cron job to be %q, got %q", expectedSchedule, schedule)
+		}
+		return nil
+	}
+}
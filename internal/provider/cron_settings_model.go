@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/cron"
+)
+
+type CronSettingsModel struct {
+	ID          types.String `tfsdk:"id"`
+	Email       types.String `tfsdk:"email"`
+	Variables   types.Map    `tfsdk:"variables"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// CronSettingsVariablesAPIToModel returns the crontab variables other than MAILTO, which is managed as the email.
+func CronSettingsVariablesAPIToModel(cronJobs *cron.CronJobDataSourceModel) types.Map {
+	variables := map[string]attr.Value{}
+	for key, value := range cronJobs.Variables() {
+		if key != "MAILTO" {
+			variables[key] = types.StringValue(value)
+		}
+	}
+
+	return types.MapValueMust(types.StringType, variables)
+}
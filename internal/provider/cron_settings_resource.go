@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"terraform-provider-cpanel/internal/cpanel/cron"
+	"time"
+)
+
+// cronVariablePattern matches the names of the variables of the crontab.
+var cronVariablePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &cronSettingsResource{}
+	_ resource.ResourceWithConfigure   = &cronSettingsResource{}
+	_ resource.ResourceWithImportState = &cronSettingsResource{}
+)
+
+// NewCronSettingsResource is a helper function to simplify the provider implementation.
+func NewCronSettingsResource() resource.Resource {
+	return &cronSettingsResource{}
+}
+
+// cronSettingsResource is the resource implementation.
+type cronSettingsResource struct {
+	client *cron.Client
+}
+
+// Metadata returns the resource type name.
+func (r *cronSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cron_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *cronSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the variables of the crontab, including the address the output of the cron jobs is sent to, the MAILTO variable. There should be a single instance of this resource per account. The crontab is left unchanged when the resource is destroyed.",
+		MarkdownDescription: "Manages the variables of the crontab, including the address the output of the cron jobs is sent to, the `MAILTO` variable. There should be a single instance of this resource per account. The crontab is left unchanged when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The cPanel username of the account.",
+				MarkdownDescription: "The cPanel username of the account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				Description:         "The address the output of the cron jobs is sent to. An empty string stops sending it.",
+				MarkdownDescription: "The address the output of the cron jobs is sent to. An empty string stops sending it.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([^@\s]+@[^@\s]+)?$`), "must be an email address or an empty string"),
+				},
+			},
+			"variables": schema.MapAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The other variables of the crontab, such as SHELL or PATH. When set, the variables missing from the map are removed from the crontab. Otherwise, the variables are only reported.",
+				MarkdownDescription: "The other variables of the crontab, such as `SHELL` or `PATH`. When set, the variables missing from the map are removed from the crontab. Otherwise, the variables are only reported.",
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(cronVariablePattern, "must be a valid variable name"),
+						stringvalidator.NoneOf("MAILTO"),
+					),
+				},
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *cronSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state CronSettingsModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read cron settings
+	email, err := r.client.GetEmail()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting cron email",
+			"Could not get cron email, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	cronJobs, err := r.client.GetCronJobs()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting crons",
+			"Could not get crons, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Email = types.StringValue(email)
+	state.Variables = CronSettingsVariablesAPIToModel(cronJobs)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *cronSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan CronSettingsModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Variables are only managed when they are configured
+	var configVariables types.Map
+
+	diags = req.Config.GetAttribute(ctx, path.Root("variables"), &configVariables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]string
+	if !configVariables.IsNull() {
+		variables = map[string]string{}
+		resp.Diagnostics.Append(plan.Variables.ElementsAs(ctx, &variables, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.setSettings(&plan, variables)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating cron settings",
+			"Could not create cron settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(r.client.Auth.Username)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *cronSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan CronSettingsModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Variables are only managed when they are configured
+	var configVariables types.Map
+
+	diags = req.Config.GetAttribute(ctx, path.Root("variables"), &configVariables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var variables map[string]string
+	if !configVariables.IsNull() {
+		variables = map[string]string{}
+		resp.Diagnostics.Append(plan.Variables.ElementsAs(ctx, &variables, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.setSettings(&plan, variables)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating cron settings",
+			"Could not update cron settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state, leaving the variables unchanged as their previous values are unknown.
+func (r *cronSettingsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+func (r *cronSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cronSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	cronClient, ok := providerData["cron"].(*cron.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Cron Client Type",
+			fmt.Sprintf("Expected *cron.Client, got: %T. Please report this issue to the provider developers.", providerData["cron"]),
+		)
+		return
+	}
+
+	r.client = cronClient
+}
+
+// setSettings sets the planned email and, unless they are nil, the variables, and reads the crontab
+// variables.
+func (r *cronSettingsResource) setSettings(plan *CronSettingsModel, variables map[string]string) error {
+	var email cron.EmailUpdateModel
+	email.Email = plan.Email.ValueString()
+
+	_, err := r.client.SetEmail(email)
+	if err != nil {
+		return err
+	}
+
+	if variables != nil {
+		err = r.setVariables(variables)
+		if err != nil {
+			return err
+		}
+	}
+
+	cronJobs, err := r.client.GetCronJobs()
+	if err != nil {
+		return err
+	}
+
+	plan.Variables = CronSettingsVariablesAPIToModel(cronJobs)
+
+	return nil
+}
+
+// setVariables makes the crontab variables other than MAILTO match the given ones. Lines are edited
+// first and removed from the last one, so that the line keys read beforehand stay valid.
+func (r *cronSettingsResource) setVariables(variables map[string]string) error {
+	cronJobs, err := r.client.GetCronJobs()
+	if err != nil {
+		return err
+	}
+
+	var removed []int64
+	for key, value := range cronJobs.Variables() {
+		if key == "MAILTO" {
+			continue
+		}
+
+		line := cronJobs.FindVariable(key)
+		expected, ok := variables[key]
+		switch {
+		case !ok:
+			removed = append(removed, line.LineKey)
+		case expected != value:
+			var variable cron.VariableUpdateModel
+			variable.LineKey = line.LineKey
+			variable.Key = key
+			variable.Value = expected
+
+			_, err = r.client.UpdateVariable(variable)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	sort.Slice(removed, func(i, j int) bool { return removed[i] > removed[j] })
+	for _, lineKey := range removed {
+		var line cron.CronJobDeleteModel
+		line.LineKey = lineKey
+
+		_, err = r.client.DeleteCronJob(line)
+		if err != nil {
+			return err
+		}
+	}
+
+	keys := make([]string, 0, len(variables))
+	for key := range variables {
+		if cronJobs.FindVariable(key) == nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var variable cron.VariableCreateModel
+		variable.Key = key
+		variable.Value = variables[key]
+
+		_, err = r.client.AddVariable(variable)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
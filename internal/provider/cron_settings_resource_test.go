@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccCronSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "cron@bolo8774.odns.fr"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "email", "cron@bolo8774.odns.fr"),
+					resource.TestCheckResourceAttrSet("cpanel_cron_settings.settings", "id"),
+					resource.TestCheckResourceAttrSet("cpanel_cron_settings.settings", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cpanel_cron_settings.settings",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestCronSettingsResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.SetCronVariable("SHELL", "/bin/bash")
+	server.AddCronJob(cpaneltest.CronJob{Minute: "0", Hour: "*", Day: "*", Month: "*", Weekday: "*", Command: "/usr/bin/true"})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "cron@example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "id", cpaneltest.Username),
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "email", "cron@example.com"),
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "variables.%", "1"),
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "variables.SHELL", "/bin/bash"),
+					resource.TestCheckResourceAttrSet("cpanel_cron_settings.settings", "last_updated"),
+					checkCronVariable(server, "MAILTO", "cron@example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cpanel_cron_settings.settings",
+				ImportStateId:           cpaneltest.Username,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					server.SetCronVariable("MAILTO", "someone@example.com")
+				},
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "cron@example.com"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing, also reporting the variables changed outside Terraform
+			{
+				PreConfig: func() {
+					server.SetCronVariable("PATH", "/usr/local/bin:/usr/bin:/bin")
+				},
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = ""
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "email", ""),
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "variables.%", "2"),
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "variables.PATH", "/usr/local/bin:/usr/bin:/bin"),
+					checkCronVariable(server, "MAILTO", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestCronSettingsResourceVariables(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.SetCronVariable("SHELL", "/bin/sh")
+	server.SetCronVariable("LANG", "C")
+	server.AddCronJob(cpaneltest.CronJob{Minute: "0", Hour: "*", Day: "*", Month: "*", Weekday: "*", Command: "/usr/bin/true"})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create testing, the variables missing from the map being removed
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "cron@example.com"
+						variables = {
+							SHELL = "/bin/bash"
+							PATH  = "/usr/bin:/bin"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "variables.%", "2"),
+					checkCronVariables(server, map[string]string{"MAILTO": "cron@example.com", "SHELL": "/bin/bash", "PATH": "/usr/bin:/bin"}),
+					checkCronJobUnchanged(server, "/usr/bin/true"),
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					server.SetCronVariable("PATH", "/bin")
+				},
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "cron@example.com"
+						variables = {
+							SHELL = "/bin/bash"
+							PATH  = "/usr/bin:/bin"
+						}
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "cron@example.com"
+						variables = {
+							PATH = "/usr/local/bin:/usr/bin:/bin"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_cron_settings.settings", "variables.%", "1"),
+					checkCronVariables(server, map[string]string{"MAILTO": "cron@example.com", "PATH": "/usr/local/bin:/usr/bin:/bin"}),
+					checkCronJobUnchanged(server, "/usr/bin/true"),
+				),
+			},
+			// Variables are left unchanged once they are no longer configured
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "cron@example.com"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestCronSettingsResourceValidation(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = "not an address"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`must be an email address or an empty\s+string`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = ""
+						variables = {
+							MAILTO = "cron@example.com"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`value must be none of`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_cron_settings" "settings" {
+						email = ""
+						variables = {
+							"NO SPACE" = "value"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`must be a valid variable name`),
+			},
+		},
+	})
+}
+
+// checkCronVariable checks the value of a variable of the crontab.
+func checkCronVariable(server *cpaneltest.Server, key, value string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		actual, ok := server.CronVariables()[key]
+		if !ok || actual != value {
+			return fmt.Errorf("expected the crontab variable %s to be %q, got %q", key, value, actual)
+		}
+		return nil
+	}
+}
+
+// checkCronVariables checks that the variables are the only ones of the crontab.
+func checkCronVariables(server *cpaneltest.Server, expected map[string]string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		actual := server.CronVariables()
+		if fmt.Sprint(actual) != fmt.Sprint(expected) {
+			return fmt.Errorf("expected the crontab variables to be %v, got %v", expected, actual)
+		}
+		return nil
+	}
+}
+
+// checkCronJobUnchanged checks that the crontab holds a single command line, running the command.
+func checkCronJobUnchanged(server *cpaneltest.Server, command string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if jobs := server.CronJobs(); len(jobs) != 1 || jobs[0].Command != command {
+			return fmt.Errorf("expected the cron job %q to be left unchanged, got: %+v", command, jobs)
+		}
+		return nil
+	}
+}
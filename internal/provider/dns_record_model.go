@@ -0,0 +1,329 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net"
+	"strconv"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel/dns"
+)
+
+// dnsRecordTypes lists the record types managed by the cpanel_dns_record resource.
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "CAA"}
+
+// dnsDefaultTTL is the time to live given to records by cPanel.
+const dnsDefaultTTL = 14400
+
+// dnsTXTChunkSize is the maximum length of a TXT record character string.
+const dnsTXTChunkSize = 255
+
+type DNSRecordModel struct {
+	Zone        types.String `tfsdk:"zone"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	TTL         types.Int64  `tfsdk:"ttl"`
+	Value       types.String `tfsdk:"value"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Port        types.Int64  `tfsdk:"port"`
+	Flags       types.Int64  `tfsdk:"flags"`
+	Tag         types.String `tfsdk:"tag"`
+	LineIndex   types.Int64  `tfsdk:"line_index"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// DNSRecordModelToAPI returns the record of the model, without line index.
+func DNSRecordModelToAPI(dnsRecordModel DNSRecordModel) dns.Record {
+	return dns.Record{
+		Name: dns.FQDN(dnsRecordModel.Name.ValueString(), dnsRecordModel.Zone.ValueString()),
+		TTL:  dnsRecordModel.TTL.ValueInt64(),
+		Type: dnsRecordModel.Type.ValueString(),
+		Data: dnsRecordData(dnsRecordModel),
+	}
+}
+
+// DNSRecordAPIToModel returns the model of a record of the zone, named as given.
+func DNSRecordAPIToModel(record dns.Record, zone, name string) *DNSRecordModel {
+	dnsRecordModel := &DNSRecordModel{
+		Zone:      types.StringValue(zone),
+		Name:      types.StringValue(name),
+		Type:      types.StringValue(record.Type),
+		TTL:       types.Int64Value(record.TTL),
+		Priority:  types.Int64Null(),
+		Weight:    types.Int64Null(),
+		Port:      types.Int64Null(),
+		Flags:     types.Int64Null(),
+		Tag:       types.StringNull(),
+		LineIndex: types.Int64Value(record.LineIndex),
+	}
+
+	data := append([]string{}, record.Data...)
+	switch record.Type {
+	case "MX":
+		data = padData(data, 2)
+		dnsRecordModel.Priority = parseInt64Value(data[0])
+		dnsRecordModel.Value = types.StringValue(data[1])
+	case "SRV":
+		data = padData(data, 4)
+		dnsRecordModel.Priority = parseInt64Value(data[0])
+		dnsRecordModel.Weight = parseInt64Value(data[1])
+		dnsRecordModel.Port = parseInt64Value(data[2])
+		dnsRecordModel.Value = types.StringValue(data[3])
+	case "CAA":
+		data = padData(data, 3)
+		dnsRecordModel.Flags = parseInt64Value(data[0])
+		dnsRecordModel.Tag = types.StringValue(data[1])
+		dnsRecordModel.Value = types.StringValue(data[2])
+	case "TXT":
+		dnsRecordModel.Value = types.StringValue(strings.Join(data, ""))
+	default:
+		dnsRecordModel.Value = types.StringValue(strings.Join(padData(data, 1)[:1], ""))
+	}
+
+	return dnsRecordModel
+}
+
+// FindDNSRecord returns the record of the zone managed by the model. cPanel identifies
+// records by their line index, which shifts as other records are added or removed, so
+// records are matched by content, the closest one to the known line index winning among
+// identical records. Records changed outside Terraform are found by FindEditedDNSRecord.
+func FindDNSRecord(zone *dns.Zone, dnsRecordModel DNSRecordModel) *dns.Record {
+	expected := DNSRecordModelToAPI(dnsRecordModel)
+	lineIndex := dnsRecordModel.LineIndex.ValueInt64()
+
+	var found *dns.Record
+	for i, record := range zone.Records {
+		if !dnsRecordSameName(record, expected) || !DNSRecordDataEqual(record.Type, record.Data, expected.Data) {
+			continue
+		}
+
+		if found == nil || dnsLineDistance(record.LineIndex, lineIndex) < dnsLineDistance(found.LineIndex, lineIndex) {
+			found = &zone.Records[i]
+		}
+	}
+
+	return found
+}
+
+// FindEditedDNSRecord returns the record at the known line index of the model, with its name and
+// type, when it was not found by FindDNSRecord as its content was changed outside Terraform. It is only
+// considered as the same record when the zone still holds the given number of records with this name
+// and type, counted when the record was last read, so that a record deleted outside Terraform does not
+// take over another record shifted to its line.
+func FindEditedDNSRecord(zone *dns.Zone, dnsRecordModel DNSRecordModel, siblings int) *dns.Record {
+	if dnsRecordModel.LineIndex.IsNull() || dnsRecordModel.LineIndex.IsUnknown() || siblings != DNSRecordSiblings(zone, dnsRecordModel) {
+		return nil
+	}
+
+	expected := DNSRecordModelToAPI(dnsRecordModel)
+	for i, record := range zone.Records {
+		if record.LineIndex == dnsRecordModel.LineIndex.ValueInt64() && dnsRecordSameName(record, expected) {
+			return &zone.Records[i]
+		}
+	}
+
+	return nil
+}
+
+// DNSRecordSiblings returns the number of records of the zone with the name and type of the model.
+func DNSRecordSiblings(zone *dns.Zone, dnsRecordModel DNSRecordModel) int {
+	expected := DNSRecordModelToAPI(dnsRecordModel)
+
+	siblings := 0
+	for _, record := range zone.Records {
+		if dnsRecordSameName(record, expected) {
+			siblings++
+		}
+	}
+
+	return siblings
+}
+
+// DNSRecordDataEqual compares the data of two records of the given type, ignoring the
+// case and the trailing dot of host names, the notation of IPv6 addresses and the
+// splitting of TXT records.
+func DNSRecordDataEqual(recordType string, a, b []string) bool {
+	if recordType == "TXT" {
+		return strings.Join(a, "") == strings.Join(b, "")
+	}
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if normalizeDNSData(recordType, i, a[i]) != normalizeDNSData(recordType, i, b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateDNSRecord checks that the attributes of the record match its type. Errors are
+// reported on the attributes below root.
+func validateDNSRecord(record DNSRecordModel, root path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if record.Type.IsUnknown() || record.Type.IsNull() {
+		return diags
+	}
+
+	recordType := record.Type.ValueString()
+	attributes := []struct {
+		name    string
+		set     bool
+		allowed bool
+	}{
+		{"priority", !record.Priority.IsNull(), recordType == "MX" || recordType == "SRV"},
+		{"weight", !record.Weight.IsNull(), recordType == "SRV"},
+		{"port", !record.Port.IsNull(), recordType == "SRV"},
+		{"flags", !record.Flags.IsNull(), recordType == "CAA"},
+		{"tag", !record.Tag.IsNull(), recordType == "CAA"},
+	}
+
+	for _, attribute := range attributes {
+		switch {
+		case attribute.allowed && !attribute.set:
+			diags.AddAttributeError(
+				root.AtName(attribute.name),
+				"Missing Attribute",
+				fmt.Sprintf("%s records require the %s attribute.", recordType, attribute.name),
+			)
+		case !attribute.allowed && attribute.set:
+			diags.AddAttributeError(
+				root.AtName(attribute.name),
+				"Unexpected Attribute",
+				fmt.Sprintf("%s records do not take the %s attribute.", recordType, attribute.name),
+			)
+		}
+	}
+
+	if record.Value.IsUnknown() || record.Value.IsNull() {
+		return diags
+	}
+
+	ip := net.ParseIP(record.Value.ValueString())
+	switch {
+	case recordType == "A" && (ip == nil || ip.To4() == nil):
+		diags.AddAttributeError(
+			root.AtName("value"),
+			"Invalid Address",
+			fmt.Sprintf("A records require an IPv4 address. Got: %q", record.Value.ValueString()),
+		)
+	case recordType == "AAAA" && (ip == nil || ip.To4() != nil):
+		diags.AddAttributeError(
+			root.AtName("value"),
+			"Invalid Address",
+			fmt.Sprintf("AAAA records require an IPv6 address. Got: %q", record.Value.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// DNSRelativeName returns the name of a record relative to its zone, "@" for the zone itself.
+func DNSRelativeName(fqdn, zone string) string {
+	zoneFQDN := dns.FQDN("@", zone)
+
+	switch {
+	case strings.EqualFold(fqdn, zoneFQDN):
+		return "@"
+	case strings.HasSuffix(strings.ToLower(fqdn), "."+zoneFQDN):
+		return fqdn[:len(fqdn)-len(zoneFQDN)-1]
+	default:
+		return fqdn
+	}
+}
+
+func dnsRecordData(dnsRecordModel DNSRecordModel) []string {
+	value := dnsRecordModel.Value.ValueString()
+
+	switch dnsRecordModel.Type.ValueString() {
+	case "CNAME", "NS":
+		return []string{dnsHostName(value)}
+	case "MX":
+		return []string{formatInt64Value(dnsRecordModel.Priority), dnsHostName(value)}
+	case "SRV":
+		return []string{
+			formatInt64Value(dnsRecordModel.Priority),
+			formatInt64Value(dnsRecordModel.Weight),
+			formatInt64Value(dnsRecordModel.Port),
+			dnsHostName(value),
+		}
+	case "CAA":
+		return []string{formatInt64Value(dnsRecordModel.Flags), dnsRecordModel.Tag.ValueString(), value}
+	case "TXT":
+		// TXT records longer than 255 characters are split into several strings
+		chunks := []string{}
+		for len(value) > dnsTXTChunkSize {
+			chunks = append(chunks, value[:dnsTXTChunkSize])
+			value = value[dnsTXTChunkSize:]
+		}
+		return append(chunks, value)
+	default:
+		return []string{value}
+	}
+}
+
+// dnsHostName returns the host name with a trailing dot, so that cPanel does not
+// append the zone to it.
+func dnsHostName(value string) string {
+	if value == "" || strings.HasSuffix(value, ".") {
+		return value
+	}
+
+	return value + "."
+}
+
+func dnsRecordSameName(a, b dns.Record) bool {
+	return strings.EqualFold(a.Type, b.Type) && strings.EqualFold(a.Name, b.Name)
+}
+
+// normalizeDNSData normalizes the i-th data field of a record of the given type.
+func normalizeDNSData(recordType string, i int, value string) string {
+	switch {
+	case recordType == "AAAA" || recordType == "A":
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	case (recordType == "CNAME" || recordType == "NS") && i == 0, recordType == "MX" && i == 1, recordType == "SRV" && i == 3:
+		return strings.TrimSuffix(strings.ToLower(value), ".")
+	case recordType == "CAA" && i == 1:
+		return strings.ToLower(value)
+	}
+
+	return value
+}
+
+func dnsLineDistance(a, b int64) int64 {
+	if a > b {
+		return a - b
+	}
+
+	return b - a
+}
+
+func padData(data []string, length int) []string {
+	for len(data) < length {
+		data = append(data, "")
+	}
+
+	return data
+}
+
+func parseInt64Value(value string) types.Int64 {
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(i)
+}
+
+func formatInt64Value(value types.Int64) string {
+	return strconv.FormatInt(value.ValueInt64(), 10)
+}
@@ -0,0 +1,465 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/dns"
+	"time"
+)
+
+// dnsRecordSiblingsKey is the private state key of the number of records with the same name and type,
+// counted when the record was last read. It is not set on creation, as records with the same name and
+// type created afterwards in the same run would not be counted.
+const dnsRecordSiblingsKey = "siblings"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsRecordResource{}
+	_ resource.ResourceWithConfigure      = &dnsRecordResource{}
+	_ resource.ResourceWithImportState    = &dnsRecordResource{}
+	_ resource.ResourceWithValidateConfig = &dnsRecordResource{}
+)
+
+// NewDNSRecordResource is a helper function to simplify the provider implementation.
+func NewDNSRecordResource() resource.Resource {
+	return &dnsRecordResource{}
+}
+
+// dnsRecordResource is the resource implementation.
+type dnsRecordResource struct {
+	client *dns.Client
+}
+
+// Metadata returns the resource type name.
+func (r *dnsRecordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a record of a DNS zone. Changes are rejected by cPanel when the zone was modified since it was read, and are then retried against the new zone.",
+		MarkdownDescription: "Manages a record of a DNS zone. Changes are rejected by cPanel when the zone was modified since it was read, and are then retried against the new zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain of the zone.",
+				MarkdownDescription: "The domain of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The record name, relative to the zone (@ for the zone itself), or fully qualified with a trailing dot.",
+				MarkdownDescription: "The record name, relative to the zone (`@` for the zone itself), or fully qualified with a trailing dot.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The record type: A, AAAA, CNAME, MX, TXT, SRV or CAA.",
+				MarkdownDescription: "The record type: `A`, `AAAA`, `CNAME`, `MX`, `TXT`, `SRV` or `CAA`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dnsRecordTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(dnsDefaultTTL),
+				Description:         "The time to live of the record, in seconds.",
+				MarkdownDescription: "The time to live of the record, in seconds.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				Description:         "The address of A and AAAA records, the fully qualified target host of CNAME, MX and SRV records, the text of TXT records, or the value of CAA records.",
+				MarkdownDescription: "The address of `A` and `AAAA` records, the fully qualified target host of `CNAME`, `MX` and `SRV` records, the text of `TXT` records, or the value of `CAA` records.",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The priority of MX and SRV records.",
+				MarkdownDescription: "The priority of `MX` and `SRV` records.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"weight": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The weight of SRV records.",
+				MarkdownDescription: "The weight of `SRV` records.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The port of SRV records.",
+				MarkdownDescription: "The port of `SRV` records.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"flags": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The flags of CAA records, 0 or 128 for critical.",
+				MarkdownDescription: "The flags of `CAA` records, `0` or `128` for critical.",
+				Validators: []validator.Int64{
+					int64validator.Between(0, 255),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The tag of CAA records: issue, issuewild or iodef.",
+				MarkdownDescription: "The tag of `CAA` records: `issue`, `issuewild` or `iodef`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("issue", "issuewild", "iodef"),
+				},
+			},
+			"line_index": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The line of the record in the zone file when it was last read. It changes as other records are added or removed.",
+				MarkdownDescription: "The line of the record in the zone file when it was last read. It changes as other records are added or removed.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the attributes match the record type.
+func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DNSRecordModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDNSRecord(config, path.Empty())...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state DNSRecordModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read zone
+	zone, err := r.client.GetZone(state.Zone.ValueString())
+
+	// Remove the record from the state if its zone has been deleted outside Terraform
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting DNS zone",
+			"Could not get DNS zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	record := FindDNSRecord(zone, state)
+
+	// Find the record at its known line if its content has been changed outside Terraform
+	if record == nil {
+		siblings, diags := req.Private.GetKey(ctx, dnsRecordSiblingsKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if count, err := strconv.Atoi(string(siblings)); err == nil {
+			record = FindEditedDNSRecord(zone, state, count)
+		}
+	}
+
+	// Remove the record from the state if it has been deleted outside Terraform
+	if record == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Refresh the values changed outside Terraform, keeping the configured notation of equal values
+	if !DNSRecordDataEqual(record.Type, record.Data, dnsRecordData(state)) {
+		recordModel := DNSRecordAPIToModel(*record, state.Zone.ValueString(), state.Name.ValueString())
+		state.Value = recordModel.Value
+		state.Priority = recordModel.Priority
+		state.Weight = recordModel.Weight
+		state.Port = recordModel.Port
+		state.Flags = recordModel.Flags
+		state.Tag = recordModel.Tag
+	}
+
+	state.TTL = types.Int64Value(record.TTL)
+	state.LineIndex = types.Int64Value(record.LineIndex)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, dnsRecordSiblingsKey, dnsRecordSiblingsValue(zone, state))...)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan DNSRecordModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LineIndex = types.Int64Null()
+
+	// Add the record to the zone
+	err := r.client.EditZone(plan.Zone.ValueString(), func(zone *dns.Zone) (*dns.ZoneMassEditModel, error) {
+		if FindDNSRecord(zone, plan) != nil {
+			return nil, fmt.Errorf("the %s record %s already exists, import it instead", plan.Type.ValueString(), plan.Name.ValueString())
+		}
+
+		return &dns.ZoneMassEditModel{Add: []dns.Record{DNSRecordModelToAPI(plan)}}, nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS record",
+			"Could not create DNS record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Read the line index of the new record
+	zone, err := r.client.GetZone(plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting DNS zone",
+			"Could not get DNS zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	record := FindDNSRecord(zone, plan)
+	if record == nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS record",
+			"Could not find the created DNS record in the zone.",
+		)
+		return
+	}
+
+	plan.LineIndex = types.Int64Value(record.LineIndex)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan DNSRecordModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DNSRecordModel
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Edit the record in place, at its current line
+	err := r.client.EditZone(plan.Zone.ValueString(), func(zone *dns.Zone) (*dns.ZoneMassEditModel, error) {
+		current := FindDNSRecord(zone, state)
+		if current == nil {
+			return nil, fmt.Errorf("the %s record %s no longer exists", state.Type.ValueString(), state.Name.ValueString())
+		}
+
+		record := DNSRecordModelToAPI(plan)
+		record.LineIndex = current.LineIndex
+		plan.LineIndex = types.Int64Value(current.LineIndex)
+
+		return &dns.ZoneMassEditModel{Edit: []dns.Record{record}}, nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNS record",
+			"Could not update DNS record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state DNSRecordModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the record from the zone
+	err := r.client.EditZone(state.Zone.ValueString(), func(zone *dns.Zone) (*dns.ZoneMassEditModel, error) {
+		current := FindDNSRecord(zone, state)
+		if current == nil {
+			return nil, nil
+		}
+
+		return &dns.ZoneMassEditModel{Remove: []int64{current.LineIndex}}, nil
+	})
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting DNS record",
+			"Could not delete DNS record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a record from a "zone/name/type" identifier, followed by "/value"
+// when several records share the name and type.
+func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: zone/name/type or zone/name/type/value. Got: %q", req.ID),
+		)
+		return
+	}
+
+	zoneName, name, recordType := parts[0], parts[1], strings.ToUpper(parts[2])
+
+	zone, err := r.client.GetZone(zoneName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting DNS zone",
+			"Could not get DNS zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var records []*DNSRecordModel
+	for _, record := range zone.Records {
+		if record.Type != recordType || record.Name != dns.FQDN(name, zoneName) {
+			continue
+		}
+
+		recordModel := DNSRecordAPIToModel(record, zoneName, name)
+		if len(parts) == 4 {
+			selected := *recordModel
+			selected.Value = types.StringValue(parts[3])
+			if !DNSRecordDataEqual(recordType, record.Data, dnsRecordData(selected)) {
+				continue
+			}
+		}
+
+		records = append(records, recordModel)
+	}
+
+	if len(records) != 1 {
+		resp.Diagnostics.AddError(
+			"Error importing DNS record",
+			fmt.Sprintf("Expected one %s record %s in the zone %s, found %d. Add the value to the identifier to select a record.", recordType, name, zoneName, len(records)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, records[0])...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, dnsRecordSiblingsKey, dnsRecordSiblingsValue(zone, *records[0]))...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsRecordResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	dnsClient, ok := providerData["dns"].(*dns.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DNS Client Type",
+			fmt.Sprintf("Expected *dns.Client, got: %T. Please report this issue to the provider developers.", providerData["dns"]),
+		)
+		return
+	}
+
+	r.client = dnsClient
+}
+
+// dnsRecordSiblingsValue returns the private state value counting the records with the name and type
+// of the model, read by FindEditedDNSRecord.
+func dnsRecordSiblingsValue(zone *dns.Zone, dnsRecordModel DNSRecordModel) []byte {
+	return []byte(strconv.Itoa(DNSRecordSiblings(zone, dnsRecordModel)))
+}
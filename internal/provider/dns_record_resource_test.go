@@ -0,0 +1,367 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/dns"
+)
+
+func TestAccDNSRecordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_dns_record" "record" {
+						zone = "bolo8774.odns.fr"
+						name = "tf-acc-record"
+						type = "TXT"
+						value = "terraform acceptance test"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_record.record", "zone", "bolo8774.odns.fr"),
+					resource.TestCheckResourceAttr("cpanel_dns_record.record", "ttl", "14400"),
+					resource.TestCheckResourceAttrSet("cpanel_dns_record.record", "line_index"),
+					resource.TestCheckResourceAttrSet("cpanel_dns_record.record", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_dns_record.record",
+				ImportStateId:                        "bolo8774.odns.fr/tf-acc-record/TXT",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_dns_record" "record" {
+						zone = "bolo8774.odns.fr"
+						name = "tf-acc-record"
+						type = "TXT"
+						ttl = 300
+						value = "terraform acceptance test, updated"
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("cpanel_dns_record.record", "ttl", "300"),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddDNSZone("example.com")
+
+	config := server.ProviderConfig() + `
+		resource "cpanel_dns_record" "a" {
+			zone = "example.com"
+			name = "app"
+			type = "A"
+			value = "192.0.2.10"
+		}
+
+		resource "cpanel_dns_record" "aaaa" {
+			zone = "example.com"
+			name = "app.example.com."
+			type = "AAAA"
+			ttl = 300
+			value = "2001:db8::10"
+		}
+
+		resource "cpanel_dns_record" "mx" {
+			zone = "example.com"
+			name = "@"
+			type = "MX"
+			priority = 10
+			value = "mx.example.net"
+		}
+
+		resource "cpanel_dns_record" "srv" {
+			zone = "example.com"
+			name = "_sip._tcp"
+			type = "SRV"
+			priority = 10
+			weight = 5
+			port = 5060
+			value = "sip.example.com"
+		}
+
+		resource "cpanel_dns_record" "caa" {
+			zone = "example.com"
+			name = "@"
+			type = "CAA"
+			flags = 0
+			tag = "issue"
+			value = "letsencrypt.org"
+		}
+
+		resource "cpanel_dns_record" "txt" {
+			zone = "example.com"
+			name = "_long"
+			type = "TXT"
+			value = "` + strings.Repeat("x", 300) + `"
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_record.a", "ttl", "14400"),
+					resource.TestCheckResourceAttrSet("cpanel_dns_record.a", "line_index"),
+					resource.TestCheckResourceAttrSet("cpanel_dns_record.a", "last_updated"),
+					checkDNSRecord(server, "example.com", "app.example.com.", "A", "192.0.2.10"),
+					checkDNSRecord(server, "example.com", "app.example.com.", "AAAA", "2001:db8::10"),
+					checkDNSRecord(server, "example.com", "example.com.", "MX", "10", "mx.example.net."),
+					checkDNSRecord(server, "example.com", "_sip._tcp.example.com.", "SRV", "10", "5", "5060", "sip.example.com."),
+					checkDNSRecord(server, "example.com", "example.com.", "CAA", "0", "issue", "letsencrypt.org"),
+					checkDNSRecord(server, "example.com", "_long.example.com.", "TXT", strings.Repeat("x", 255), strings.Repeat("x", 45)),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_dns_record.srv",
+				ImportStateId:                        "example.com/_sip._tcp/SRV",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"value", "last_updated"},
+			},
+			// ImportState with value testing
+			{
+				ResourceName:                         "cpanel_dns_record.mx",
+				ImportStateId:                        "example.com/@/MX/mx.example.net",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "type",
+				ImportStateVerifyIgnore:              []string{"value", "last_updated"},
+			},
+			// Records are still found after the lines before them moved
+			{
+				PreConfig:          func() { server.RemoveDNSRecords("example.com", "www", "CNAME") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Update testing
+			{
+				Config: strings.Replace(config, `"192.0.2.10"`, `"192.0.2.30"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_record.a", "value", "192.0.2.30"),
+					checkDNSRecord(server, "example.com", "app.example.com.", "A", "192.0.2.30"),
+					checkDNSRecordCount(server, "example.com", "app.example.com.", "A", 1),
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.RemoveDNSRecords("example.com", "_sip._tcp", "SRV") },
+				Config:    strings.Replace(config, `"192.0.2.10"`, `"192.0.2.30"`, 1),
+				Check:     checkDNSRecord(server, "example.com", "_sip._tcp.example.com.", "SRV", "10", "5", "5060", "sip.example.com."),
+			},
+			// Drift testing
+			{
+				PreConfig:          func() { server.SetDNSRecordData("example.com", "app", "A", "192.0.2.20") },
+				Config:             strings.Replace(config, `"192.0.2.10"`, `"192.0.2.30"`, 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing, the record changed outside Terraform being restored in place
+			{
+				Config: strings.Replace(config, `"192.0.2.10"`, `"192.0.2.30"`, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_record.a", "value", "192.0.2.30"),
+					checkDNSRecord(server, "example.com", "app.example.com.", "A", "192.0.2.30"),
+					checkDNSRecordCount(server, "example.com", "app.example.com.", "A", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceSameNameRecords(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddDNSZone("example.com")
+
+	config := server.ProviderConfig() + `
+		resource "cpanel_dns_record" "spf" {
+			zone = "example.com"
+			name = "@"
+			type = "TXT"
+			value = "v=spf1 -all"
+		}
+
+		resource "cpanel_dns_record" "verification" {
+			zone = "example.com"
+			name = "@"
+			type = "TXT"
+			value = "site-verification=abc"
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  checkDNSRecordCount(server, "example.com", "example.com.", "TXT", 2),
+			},
+			// A record deleted outside Terraform does not take over the other record
+			{
+				PreConfig: func() { server.RemoveDNSRecord("example.com", "@", "TXT", "site-verification=abc") },
+				Config:    config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_record.spf", "value", "v=spf1 -all"),
+					resource.TestCheckResourceAttr("cpanel_dns_record.verification", "value", "site-verification=abc"),
+					checkDNSRecord(server, "example.com", "example.com.", "TXT", "v=spf1 -all"),
+					checkDNSRecord(server, "example.com", "example.com.", "TXT", "site-verification=abc"),
+					checkDNSRecordCount(server, "example.com", "example.com.", "TXT", 2),
+					checkDNSRecordLineIndexesDiffer("cpanel_dns_record.spf", "cpanel_dns_record.verification"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceRetriesOnSerialMismatch(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddDNSZone("example.com")
+	server.InjectFault(cpanel.ModuleDNS, "mass_edit_zone", cpaneltest.Fault{
+		Errors: []string{"The given serial number (2024010101) does not match the DNS zone’s serial number (2024010102). Refresh your view of the DNS zone, then resubmit."},
+		Times:  1,
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_dns_record" "txt" {
+						zone = "example.com"
+						name = "@"
+						type = "TXT"
+						value = "v=spf1 -all"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkDNSRecord(server, "example.com", "example.com.", "TXT", "v=spf1 -all"),
+					checkDNSMassEdits(server, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceValidation(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_dns_record" "mx" {
+						zone = "example.com"
+						name = "@"
+						type = "MX"
+						value = "mx.example.net"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`MX records require the priority attribute`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_dns_record" "a" {
+						zone = "example.com"
+						name = "@"
+						type = "A"
+						value = "2001:db8::1"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`A records require an IPv4 address`),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceReadRemovesDeletedRecord(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddDNSZone("example.com")
+
+	r := &dnsRecordResource{client: dns.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &DNSRecordModel{
+		Zone:        types.StringValue("example.com"),
+		Name:        types.StringValue("app"),
+		Type:        types.StringValue("A"),
+		TTL:         types.Int64Value(14400),
+		Value:       types.StringValue("192.0.2.10"),
+		Priority:    types.Int64Null(),
+		Weight:      types.Int64Null(),
+		Port:        types.Int64Null(),
+		Flags:       types.Int64Null(),
+		Tag:         types.StringNull(),
+		LineIndex:   types.Int64Value(20),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted DNS record to be removed from the state")
+	}
+}
+
+func checkDNSRecord(server *cpaneltest.Server, zone, name, recordType string, expectedData ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		for _, record := range server.DNSRecords(zone) {
+			if record.Type == recordType && dns.FQDN(record.Name, zone) == name && strings.Join(record.Data, " ") == strings.Join(expectedData, " ") {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected the %s record %s to hold %q in the zone %s", recordType, name, expectedData, zone)
+	}
+}
+
+func checkDNSRecordCount(server *cpaneltest.Server, zone, name, recordType string, expectedCount int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		count := 0
+		for _, record := range server.DNSRecords(zone) {
+			if record.Type == recordType && dns.FQDN(record.Name, zone) == name {
+				count++
+			}
+		}
+		if count != expectedCount {
+			return fmt.Errorf("expected %d %s records %s in the zone %s, got %d", expectedCount, recordType, name, zone, count)
+		}
+		return nil
+	}
+}
+
+// checkDNSRecordLineIndexesDiffer checks that two records are not bound to the same line.
+func checkDNSRecordLineIndexesDiffer(a, b string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		lineIndexA := state.RootModule().Resources[a].Primary.Attributes["line_index"]
+		lineIndexB := state.RootModule().Resources[b].Primary.Attributes["line_index"]
+		if lineIndexA == lineIndexB {
+			return fmt.Errorf("expected %s and %s to be at different lines, both are at %s", a, b, lineIndexA)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel/dns"
+)
+
+// dnsZoneRecordTypes lists the record types managed by the cpanel_dns_zone resource.
+var dnsZoneRecordTypes = append([]string{"NS"}, dnsRecordTypes...)
+
+// dnsZonePreservedNames lists the names of the records generated by cPanel, relative
+// to the zone, that are left untouched unless they are managed explicitly.
+var dnsZonePreservedNames = []string{
+	"cpanel",
+	"webmail",
+	"webdisk",
+	"whm",
+	"cpcalendars",
+	"cpcontacts",
+	"autoconfig",
+	"autodiscover",
+	"_autodiscover._tcp",
+	"_cpanel-dcv-test-record",
+}
+
+type DNSZoneModel struct {
+	Zone                  types.String `tfsdk:"zone"`
+	PreserveCpanelRecords types.Bool   `tfsdk:"preserve_cpanel_records"`
+	Records               types.Set    `tfsdk:"records"`
+	LastUpdated           types.String `tfsdk:"last_updated"`
+}
+
+type DNSZoneRecordModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Value    types.String `tfsdk:"value"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Weight   types.Int64  `tfsdk:"weight"`
+	Port     types.Int64  `tfsdk:"port"`
+	Flags    types.Int64  `tfsdk:"flags"`
+	Tag      types.String `tfsdk:"tag"`
+}
+
+// dnsZoneRecordType is the type of the elements of the records set.
+var dnsZoneRecordType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"name":     types.StringType,
+	"type":     types.StringType,
+	"ttl":      types.Int64Type,
+	"value":    types.StringType,
+	"priority": types.Int64Type,
+	"weight":   types.Int64Type,
+	"port":     types.Int64Type,
+	"flags":    types.Int64Type,
+	"tag":      types.StringType,
+}}
+
+// DNSZoneRecordModelToRecordModel returns the record of the zone as a cpanel_dns_record model.
+func DNSZoneRecordModelToRecordModel(zone string, dnsZoneRecordModel DNSZoneRecordModel) DNSRecordModel {
+	ttl := dnsZoneRecordModel.TTL
+	if ttl.IsNull() {
+		ttl = types.Int64Value(dnsDefaultTTL)
+	}
+
+	return DNSRecordModel{
+		Zone:      types.StringValue(zone),
+		Name:      dnsZoneRecordModel.Name,
+		Type:      dnsZoneRecordModel.Type,
+		TTL:       ttl,
+		Value:     dnsZoneRecordModel.Value,
+		Priority:  dnsZoneRecordModel.Priority,
+		Weight:    dnsZoneRecordModel.Weight,
+		Port:      dnsZoneRecordModel.Port,
+		Flags:     dnsZoneRecordModel.Flags,
+		Tag:       dnsZoneRecordModel.Tag,
+		LineIndex: types.Int64Null(),
+	}
+}
+
+// DNSZoneRecordAPIToModel returns the model of a record of the zone, named relatively to it.
+func DNSZoneRecordAPIToModel(record dns.Record, zone string) DNSZoneRecordModel {
+	dnsRecordModel := DNSRecordAPIToModel(record, zone, DNSRelativeName(record.Name, zone))
+
+	return DNSZoneRecordModel{
+		Name:     dnsRecordModel.Name,
+		Type:     dnsRecordModel.Type,
+		TTL:      dnsRecordModel.TTL,
+		Value:    dnsRecordModel.Value,
+		Priority: dnsRecordModel.Priority,
+		Weight:   dnsRecordModel.Weight,
+		Port:     dnsRecordModel.Port,
+		Flags:    dnsRecordModel.Flags,
+		Tag:      dnsRecordModel.Tag,
+	}
+}
+
+// DNSZoneRecords returns the models of the records of the zone. The records matching
+// one of the known records keep its notation, the default TTL staying null. The SOA
+// record is left out, as well as the records generated by cPanel when preserve is set,
+// unless a known record has the same name and type.
+func DNSZoneRecords(zone *dns.Zone, zoneName string, known []DNSZoneRecordModel, preserve bool) []DNSZoneRecordModel {
+	records := []DNSZoneRecordModel{}
+	used := make([]bool, len(known))
+
+	for _, record := range zone.Records {
+		if record.Type == "SOA" {
+			continue
+		}
+
+		matched := false
+		for i, knownRecord := range known {
+			if used[i] || !dnsZoneRecordMatches(record, zoneName, knownRecord) {
+				continue
+			}
+
+			used[i] = true
+			matched = true
+			if !(knownRecord.TTL.IsNull() && record.TTL == dnsDefaultTTL) {
+				knownRecord.TTL = types.Int64Value(record.TTL)
+			}
+			records = append(records, knownRecord)
+			break
+		}
+
+		if !matched && !(preserve && dnsZoneRecordPreserved(record, zoneName) && !dnsZoneRecordNameListed(record, zoneName, known)) {
+			records = append(records, DNSZoneRecordAPIToModel(record, zoneName))
+		}
+	}
+
+	return records
+}
+
+// DNSZoneChanges returns the changes turning the records of the zone into the desired
+// ones, or nil when there are none. Records already in the zone are kept on their line,
+// and only edited when their TTL changed. The records generated by cPanel are only
+// preserved while no desired record has the same name and type.
+func DNSZoneChanges(zone *dns.Zone, zoneName string, desired []DNSZoneRecordModel, preserve bool) *dns.ZoneMassEditModel {
+	changes := &dns.ZoneMassEditModel{}
+	kept := map[int64]bool{}
+
+	for _, desiredRecord := range desired {
+		expected := DNSRecordModelToAPI(DNSZoneRecordModelToRecordModel(zoneName, desiredRecord))
+
+		var found *dns.Record
+		for i, record := range zone.Records {
+			if !kept[record.LineIndex] && record.Type != "SOA" && dnsZoneRecordMatches(record, zoneName, desiredRecord) {
+				found = &zone.Records[i]
+				break
+			}
+		}
+
+		switch {
+		case found == nil:
+			changes.Add = append(changes.Add, expected)
+		case found.TTL != expected.TTL:
+			kept[found.LineIndex] = true
+			expected.LineIndex = found.LineIndex
+			changes.Edit = append(changes.Edit, expected)
+		default:
+			kept[found.LineIndex] = true
+		}
+	}
+
+	for _, record := range zone.Records {
+		if record.Type == "SOA" || kept[record.LineIndex] || (preserve && dnsZoneRecordPreserved(record, zoneName) && !dnsZoneRecordNameListed(record, zoneName, desired)) {
+			continue
+		}
+
+		changes.Remove = append(changes.Remove, record.LineIndex)
+	}
+
+	if len(changes.Add) == 0 && len(changes.Edit) == 0 && len(changes.Remove) == 0 {
+		return nil
+	}
+
+	return changes
+}
+
+func dnsZoneRecordMatches(record dns.Record, zoneName string, dnsZoneRecordModel DNSZoneRecordModel) bool {
+	expected := DNSRecordModelToAPI(DNSZoneRecordModelToRecordModel(zoneName, dnsZoneRecordModel))
+
+	return dnsRecordSameName(record, expected) && DNSRecordDataEqual(record.Type, record.Data, expected.Data)
+}
+
+// dnsZoneRecordNameListed reports whether one of the records has the name and type of the record.
+func dnsZoneRecordNameListed(record dns.Record, zoneName string, dnsZoneRecordModels []DNSZoneRecordModel) bool {
+	for _, dnsZoneRecordModel := range dnsZoneRecordModels {
+		if dnsRecordSameName(record, DNSRecordModelToAPI(DNSZoneRecordModelToRecordModel(zoneName, dnsZoneRecordModel))) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dnsZoneRecordPreserved reports whether the record is a NS record or was generated by cPanel.
+func dnsZoneRecordPreserved(record dns.Record, zoneName string) bool {
+	if record.Type == "NS" {
+		return true
+	}
+
+	name := strings.ToLower(DNSRelativeName(record.Name, zoneName))
+	for _, preservedName := range dnsZonePreservedNames {
+		if name == preservedName {
+			return true
+		}
+	}
+
+	return false
+}
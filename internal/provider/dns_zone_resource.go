@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/dns"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsZoneResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneResource{}
+)
+
+// NewDNSZoneResource is a helper function to simplify the provider implementation.
+func NewDNSZoneResource() resource.Resource {
+	return &dnsZoneResource{}
+}
+
+// dnsZoneResource is the resource implementation.
+type dnsZoneResource struct {
+	client *dns.Client
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages every record of a DNS zone. Records missing from the configuration are removed from the zone, and all the changes are applied at once. It should not be used along with cpanel_dns_record resources on the same zone.",
+		MarkdownDescription: "Manages every record of a DNS zone. Records missing from the configuration are removed from the zone, and all the changes are applied at once. It should not be used along with `cpanel_dns_record` resources on the same zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain of the zone.",
+				MarkdownDescription: "The domain of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preserve_cpanel_records": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				Description:         "Whether to leave the NS records and the records generated by cPanel, such as cpanel, webmail or _cpanel-dcv-test-record, untouched while no record with their name and type is configured. The SOA record is always left untouched.",
+				MarkdownDescription: "Whether to leave the `NS` records and the records generated by cPanel, such as `cpanel`, `webmail` or `_cpanel-dcv-test-record`, untouched while no record with their name and type is configured. The `SOA` record is always left untouched.",
+			},
+			"records": schema.SetNestedAttribute{
+				Required:            true,
+				Description:         "The records of the zone.",
+				MarkdownDescription: "The records of the zone.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							Description:         "The record name, relative to the zone (@ for the zone itself), or fully qualified with a trailing dot.",
+							MarkdownDescription: "The record name, relative to the zone (`@` for the zone itself), or fully qualified with a trailing dot.",
+						},
+						"type": schema.StringAttribute{
+							Required:            true,
+							Description:         "The record type: NS, A, AAAA, CNAME, MX, TXT, SRV or CAA.",
+							MarkdownDescription: "The record type: `NS`, `A`, `AAAA`, `CNAME`, `MX`, `TXT`, `SRV` or `CAA`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(dnsZoneRecordTypes...),
+							},
+						},
+						"ttl": schema.Int64Attribute{
+							Optional:            true,
+							Description:         "The time to live of the record, in seconds. Defaults to 14400.",
+							MarkdownDescription: "The time to live of the record, in seconds. Defaults to `14400`.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"value": schema.StringAttribute{
+							Required:            true,
+							Description:         "The address of A and AAAA records, the fully qualified target host of NS, CNAME, MX and SRV records, the text of TXT records, or the value of CAA records.",
+							MarkdownDescription: "The address of `A` and `AAAA` records, the fully qualified target host of `NS`, `CNAME`, `MX` and `SRV` records, the text of `TXT` records, or the value of `CAA` records.",
+						},
+						"priority": schema.Int64Attribute{
+							Optional:            true,
+							Description:         "The priority of MX and SRV records.",
+							MarkdownDescription: "The priority of `MX` and `SRV` records.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"weight": schema.Int64Attribute{
+							Optional:            true,
+							Description:         "The weight of SRV records.",
+							MarkdownDescription: "The weight of `SRV` records.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"port": schema.Int64Attribute{
+							Optional:            true,
+							Description:         "The port of SRV records.",
+							MarkdownDescription: "The port of `SRV` records.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 65535),
+							},
+						},
+						"flags": schema.Int64Attribute{
+							Optional:            true,
+							Description:         "The flags of CAA records, 0 or 128 for critical.",
+							MarkdownDescription: "The flags of `CAA` records, `0` or `128` for critical.",
+							Validators: []validator.Int64{
+								int64validator.Between(0, 255),
+							},
+						},
+						"tag": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The tag of CAA records: issue, issuewild or iodef.",
+							MarkdownDescription: "The tag of `CAA` records: `issue`, `issuewild` or `iodef`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("issue", "issuewild", "iodef"),
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the attributes of every record match its type.
+func (r *dnsZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DNSZoneModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Records.IsUnknown() || config.Records.IsNull() {
+		return
+	}
+
+	for _, element := range config.Records.Elements() {
+		object, ok := element.(basetypes.ObjectValue)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		var record DNSZoneRecordModel
+		resp.Diagnostics.Append(object.As(ctx, &record, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		recordPath := path.Root("records").AtSetValue(object)
+		resp.Diagnostics.Append(validateDNSRecord(DNSZoneRecordModelToRecordModel(config.Zone.ValueString(), record), recordPath)...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state DNSZoneModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known := []DNSZoneRecordModel{}
+	if !state.Records.IsNull() {
+		resp.Diagnostics.Append(state.Records.ElementsAs(ctx, &known, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Read zone
+	zone, err := r.client.GetZone(state.Zone.ValueString())
+
+	// Remove the zone from the state if it has been deleted outside Terraform
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting DNS zone",
+			"Could not get DNS zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	records := DNSZoneRecords(zone, state.Zone.ValueString(), known, state.PreserveCpanelRecords.ValueBool())
+	state.Records, diags = types.SetValueFrom(ctx, dnsZoneRecordType, records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create takes over the zone and sets the initial Terraform state.
+func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan DNSZoneModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := []DNSZoneRecordModel{}
+	resp.Diagnostics.Append(plan.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the records of the zone
+	err := r.setRecords(plan, records)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating DNS zone",
+			"Could not create DNS zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan DNSZoneModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := []DNSZoneRecordModel{}
+	resp.Diagnostics.Append(plan.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the records of the zone
+	err := r.setRecords(plan, records)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNS zone",
+			"Could not update DNS zone, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the managed records from the zone, leaving the others untouched.
+func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state DNSZoneModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := []DNSZoneRecordModel{}
+	resp.Diagnostics.Append(state.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zoneName := state.Zone.ValueString()
+	err := r.client.EditZone(zoneName, func(zone *dns.Zone) (*dns.ZoneMassEditModel, error) {
+		changes := &dns.ZoneMassEditModel{}
+		removed := map[int64]bool{}
+
+		for _, record := range zone.Records {
+			for _, managedRecord := range records {
+				if record.Type != "SOA" && !removed[record.LineIndex] && dnsZoneRecordMatches(record, zoneName, managedRecord) {
+					removed[record.LineIndex] = true
+					changes.Remove = append(changes.Remove, record.LineIndex)
+					break
+				}
+			}
+		}
+
+		if len(changes.Remove) == 0 {
+			return nil, nil
+		}
+
+		return changes, nil
+	})
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting DNS zone records",
+			"Could not delete DNS zone records, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a zone from its domain.
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("preserve_cpanel_records"), true)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("records"), types.SetNull(dnsZoneRecordType))...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	dnsClient, ok := providerData["dns"].(*dns.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DNS Client Type",
+			fmt.Sprintf("Expected *dns.Client, got: %T. Please report this issue to the provider developers.", providerData["dns"]),
+		)
+		return
+	}
+
+	r.client = dnsClient
+}
+
+// setRecords replaces the records of the zone with the planned ones, in a single
+// mass_edit_zone operation.
+func (r *dnsZoneResource) setRecords(plan DNSZoneModel, records []DNSZoneRecordModel) error {
+	zoneName := plan.Zone.ValueString()
+
+	return r.client.EditZone(zoneName, func(zone *dns.Zone) (*dns.ZoneMassEditModel, error) {
+		return DNSZoneChanges(zone, zoneName, records, plan.PreserveCpanelRecords.ValueBool()), nil
+	})
+}
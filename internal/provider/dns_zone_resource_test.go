@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/dns"
+)
+
+func TestAccDNSZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_dns_zone" "zone" {
+						zone = "bolo8774.odns.fr"
+						records = [
+							{ name = "@", type = "A", value = "185.230.63.107" },
+							{ name = "www", type = "CNAME", value = "bolo8774.odns.fr" },
+							{ name = "@", type = "MX", priority = 0, value = "bolo8774.odns.fr" },
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_zone.zone", "zone", "bolo8774.odns.fr"),
+					resource.TestCheckResourceAttr("cpanel_dns_zone.zone", "records.#", "3"),
+					resource.TestCheckResourceAttrSet("cpanel_dns_zone.zone", "last_updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSZoneResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddDNSZone("example.com")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_dns_zone" "zone" {
+						zone = "example.com"
+						records = [
+							{ name = "@", type = "A", value = "192.0.2.1" },
+							{ name = "www", type = "CNAME", value = "example.com" },
+							{ name = "app", type = "A", value = "192.0.2.10" },
+							{ name = "@", type = "MX", priority = 10, value = "mx.example.net" },
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_zone.zone", "preserve_cpanel_records", "true"),
+					resource.TestCheckResourceAttr("cpanel_dns_zone.zone", "records.#", "4"),
+					resource.TestCheckResourceAttrSet("cpanel_dns_zone.zone", "last_updated"),
+					checkDNSRecord(server, "example.com", "app.example.com.", "A", "192.0.2.10"),
+					checkDNSRecord(server, "example.com", "example.com.", "MX", "10", "mx.example.net."),
+					checkDNSRecord(server, "example.com", "cpanel.example.com.", "A", "192.0.2.1"),
+					checkDNSRecord(server, "example.com", "_cpanel-dcv-test-record.example.com.", "TXT", "_cpanel-dcv-test-record=test"),
+					checkDNSRecordCount(server, "example.com", "example.com.", "NS", 2),
+					checkDNSRecordCount(server, "example.com", "example.com.", "SOA", 1),
+					checkDNSRecordCount(server, "example.com", "mail.example.com.", "CNAME", 0),
+					checkDNSRecordCount(server, "example.com", "example.com.", "MX", 1),
+					checkDNSMassEdits(server, 1),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_dns_zone.zone",
+				ImportStateId:                        "example.com",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+				ImportStateVerifyIgnore:              []string{"records", "last_updated"},
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].Attributes["records.#"] != "4" {
+						return fmt.Errorf("expected the 4 unpreserved records to be imported, got %v", states)
+					}
+					return nil
+				},
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					server.AddDNSRecord("example.com", cpaneltest.DNSRecord{Name: "rogue", TTL: 14400, Type: "A", Data: []string{"192.0.2.99"}})
+				},
+				Config: server.ProviderConfig() + `
+					resource "cpanel_dns_zone" "zone" {
+						zone = "example.com"
+						records = [
+							{ name = "@", type = "A", value = "192.0.2.1" },
+							{ name = "www", type = "CNAME", value = "example.com" },
+							{ name = "app", type = "A", value = "192.0.2.10" },
+							{ name = "@", type = "MX", priority = 10, value = "mx.example.net" },
+						]
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_dns_zone" "zone" {
+						zone = "example.com"
+						records = [
+							{ name = "@", type = "A", value = "192.0.2.1" },
+							{ name = "www", type = "CNAME", value = "example.com" },
+							{ name = "app", type = "A", ttl = 300, value = "192.0.2.10" },
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_zone.zone", "records.#", "3"),
+					checkDNSRecordTTL(server, "example.com", "app.example.com.", "A", 300),
+					checkDNSRecordCount(server, "example.com", "rogue.example.com.", "A", 0),
+					checkDNSRecordCount(server, "example.com", "example.com.", "MX", 0),
+					checkDNSMassEdits(server, 2),
+				),
+			},
+			// Records generated by cPanel are removed unless preserved
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_dns_zone" "zone" {
+						zone = "example.com"
+						preserve_cpanel_records = false
+						records = [
+							{ name = "@", type = "NS", ttl = 86400, value = "ns1.example.com" },
+							{ name = "@", type = "NS", ttl = 86400, value = "ns2.example.com" },
+							{ name = "@", type = "A", value = "192.0.2.1" },
+							{ name = "www", type = "CNAME", value = "example.com" },
+							{ name = "app", type = "A", ttl = 300, value = "192.0.2.10" },
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_zone.zone", "records.#", "5"),
+					checkDNSRecordCount(server, "example.com", "example.com.", "NS", 2),
+					checkDNSRecordCount(server, "example.com", "cpanel.example.com.", "A", 0),
+					checkDNSRecordCount(server, "example.com", "_cpanel-dcv-test-record.example.com.", "TXT", 0),
+					checkDNSRecordCount(server, "example.com", "example.com.", "SOA", 1),
+				),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			records := server.DNSRecords("example.com")
+			if len(records) != 1 || records[0].Type != "SOA" {
+				return fmt.Errorf("expected only the SOA record to be left in the zone, got %v", records)
+			}
+			return nil
+		},
+	})
+}
+
+func TestDNSZoneResourcePreservedRecordOverride(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddDNSZone("example.com")
+
+	config := server.ProviderConfig() + `
+		resource "cpanel_dns_zone" "zone" {
+			zone = "example.com"
+			records = [
+				{ name = "@", type = "A", value = "192.0.2.1" },
+				{ name = "webmail", type = "A", value = "192.0.2.50" },
+			]
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A configured name and type replaces the record generated by cPanel
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_dns_zone.zone", "records.#", "2"),
+					checkDNSRecord(server, "example.com", "webmail.example.com.", "A", "192.0.2.50"),
+					checkDNSRecordCount(server, "example.com", "webmail.example.com.", "A", 1),
+					checkDNSRecord(server, "example.com", "cpanel.example.com.", "A", "192.0.2.1"),
+				),
+			},
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestDNSZoneResourceReadRemovesDeletedZone(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	r := &dnsZoneResource{client: dns.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &DNSZoneModel{
+		Zone:                  types.StringValue("example.com"),
+		PreserveCpanelRecords: types.BoolValue(true),
+		Records:               types.SetValueMust(dnsZoneRecordType, nil),
+		LastUpdated:           types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted DNS zone to be removed from the state")
+	}
+}
+
+func checkDNSRecordTTL(server *cpaneltest.Server, zone, name, recordType string, expectedTTL int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		for _, record := range server.DNSRecords(zone) {
+			if record.Type == recordType && dns.FQDN(record.Name, zone) == name && record.TTL == expectedTTL {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected the %s record %s to have a TTL of %d in the zone %s", recordType, name, expectedTTL, zone)
+	}
+}
+
+// checkDNSMassEdits checks that the zone changes were applied in the expected number of mass_edit_zone calls.
+func checkDNSMassEdits(server *cpaneltest.Server, expectedCount int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		count := 0
+		for _, call := range server.Calls() {
+			if call.Function == "mass_edit_zone" {
+				count++
+			}
+		}
+		if count != expectedCount {
+			return fmt.Errorf("expected %d mass_edit_zone calls, got %d", expectedCount, count)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,11 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DomainAliasModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	DocumentRoot types.String `tfsdk:"document_root"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &domainAliasResource{}
+	_ resource.ResourceWithConfigure   = &domainAliasResource{}
+	_ resource.ResourceWithImportState = &domainAliasResource{}
+)
+
+// NewDomainAliasResource is a helper function to simplify the provider implementation.
+func NewDomainAliasResource() resource.Resource {
+	return &domainAliasResource{}
+}
+
+// domainAliasResource is the resource implementation.
+type domainAliasResource struct {
+	client *domain.Client
+}
+
+// Metadata returns the resource type name.
+func (r *domainAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_alias"
+}
+
+// Schema defines the schema for the resource.
+func (r *domainAliasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a domain alias, formerly known as a parked domain, serving the website of the main domain.",
+		MarkdownDescription: "Manages a domain alias, formerly known as a parked domain, serving the website of the main domain.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The alias domain.",
+				MarkdownDescription: "The alias domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"document_root": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The document root of the main domain, relative to the home directory.",
+				MarkdownDescription: "The document root of the main domain, relative to the home directory.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *domainAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state DomainAliasModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read domain alias
+	parkedDomain, err := r.client.GetParkedDomain(state.Domain.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting domain alias",
+			"Could not get domain alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the domain alias from the state if it has been deleted outside Terraform
+	if parkedDomain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DocumentRoot = types.StringValue(parkedDomain.BaseDir)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *domainAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan DomainAliasModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new domain alias
+	var parkedDomain domain.ParkedDomainCreateModel
+	parkedDomain.Domain = plan.Domain.ValueString()
+
+	_, err := r.client.CreateParkedDomain(parkedDomain)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating domain alias",
+			"Could not create domain alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Read the document root of the main domain
+	createdParkedDomain, err := r.client.GetParkedDomain(parkedDomain.Domain)
+
+	if err == nil && createdParkedDomain == nil {
+		err = errors.New("the created domain alias was not found")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting domain alias",
+			"Could not get domain alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.DocumentRoot = types.StringValue(createdParkedDomain.BaseDir)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the domain alias to be replaced.
+func (r *domainAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DomainAliasModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *domainAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state DomainAliasModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing domain alias
+	var parkedDomain domain.ParkedDomainDeleteModel
+	parkedDomain.Domain = state.Domain.ValueString()
+
+	_, err := r.client.DeleteParkedDomain(parkedDomain)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting domain alias",
+			"Could not delete domain alias, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *domainAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *domainAliasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	domainClient, ok := providerData["domain"].(*domain.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Domain Client Type",
+			fmt.Sprintf("Expected *domain.Client, got: %T. Please report this issue to the provider developers.", providerData["domain"]),
+		)
+		return
+	}
+
+	r.client = domainClient
+}
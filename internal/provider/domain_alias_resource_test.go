@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+)
+
+func TestAccDomainAliasResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_domain_alias" "alias" {
+						domain = "tf-acc-alias.odns.fr"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_domain_alias.alias", "domain", "tf-acc-alias.odns.fr"),
+					resource.TestCheckResourceAttrSet("cpanel_domain_alias.alias", "document_root"),
+					resource.TestCheckResourceAttrSet("cpanel_domain_alias.alias", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_domain_alias.alias",
+				ImportStateId:                        "tf-acc-alias.odns.fr",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestDomainAliasResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_domain_alias" "alias" {
+						domain = "example.org"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_domain_alias.alias", "document_root", "public_html"),
+					resource.TestCheckResourceAttrSet("cpanel_domain_alias.alias", "last_updated"),
+					checkDomainAlias(server, "example.org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_domain_alias.alias",
+				ImportStateId:                        "example.org",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Replace testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_domain_alias" "alias" {
+						domain = "example.info"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkDomainAlias(server, "example.info"),
+					func(_ *terraform.State) error {
+						if server.ParkedDomainExists("example.org") {
+							return fmt.Errorf("expected the domain alias example.org to be deleted")
+						}
+						return nil
+					},
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeleteParkedDomain("example.info") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_domain_alias" "alias" {
+						domain = "example.info"
+					}
+				`,
+				Check: checkDomainAlias(server, "example.info"),
+			},
+			// Existing domains cannot be aliased
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_domain_alias" "alias" {
+						domain = "example.info"
+					}
+
+					resource "cpanel_domain_alias" "main" {
+						domain = "example.com"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+		},
+	})
+}
+
+func TestDomainAliasResourceReadRemovesDeletedAlias(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddParkedDomain("example.info")
+
+	r := &domainAliasResource{client: domain.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &DomainAliasModel{
+		Domain:       types.StringValue("example.org"),
+		DocumentRoot: types.StringValue("public_html"),
+		LastUpdated:  types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted domain alias to be removed from the state")
+	}
+}
+
+func checkDomainAlias(server *cpaneltest.Server, name string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if !server.ParkedDomainExists(name) {
+			return fmt.Errorf("expected the domain alias %s to exist", name)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &domainsDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainsDataSource{}
+)
+
+// NewDomainsDataSource is a helper function to simplify the provider implementation.
+func NewDomainsDataSource() datasource.DataSource {
+	return &domainsDataSource{}
+}
+
+// domainsDataSource is the data source implementation.
+type domainsDataSource struct {
+	client *domain.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *domainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	domainClient, ok := providerData["domain"].(*domain.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Domain Client Type",
+			fmt.Sprintf("Expected *domain.Client, got: %T. Please report this issue to the provider developers.", providerData["domain"]),
+		)
+		return
+	}
+
+	d.client = domainClient
+}
+
+// Metadata returns the data source type name.
+func (d *domainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+// Schema defines the schema for the data source.
+func (d *domainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	domainAttributes := map[string]schema.Attribute{
+		"domain": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The domain name.",
+			MarkdownDescription: "The domain name.",
+		},
+		"document_root": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The absolute path of the document root.",
+			MarkdownDescription: "The absolute path of the document root.",
+		},
+		"home_directory": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The absolute path of the home directory of the account.",
+			MarkdownDescription: "The absolute path of the home directory of the account.",
+		},
+		"php_version": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The PHP version serving the domain, such as ea-php81.",
+			MarkdownDescription: "The PHP version serving the domain, such as `ea-php81`.",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Lists the domains of the account.",
+		MarkdownDescription: "Lists the domains of the account.",
+		Attributes: map[string]schema.Attribute{
+			"main_domain": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "The main domain of the account.",
+				MarkdownDescription: "The main domain of the account.",
+				Attributes:          domainAttributes,
+			},
+			"addon_domains": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The addon domains.",
+				MarkdownDescription: "The addon domains.",
+				NestedObject:        schema.NestedAttributeObject{Attributes: domainAttributes},
+			},
+			"parked_domains": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The parked domains, also known as domain aliases, serving the website of the main domain.",
+				MarkdownDescription: "The parked domains, also known as domain aliases, serving the website of the main domain.",
+				NestedObject:        schema.NestedAttributeObject{Attributes: domainAttributes},
+			},
+			"sub_domains": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The subdomains.",
+				MarkdownDescription: "The subdomains.",
+				NestedObject:        schema.NestedAttributeObject{Attributes: domainAttributes},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *domainsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	domains, err := d.client.GetDomains()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read domains: %s", err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := DomainsAPIToModel(domains)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
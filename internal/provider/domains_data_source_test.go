@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccDomainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "cpanel_domains" "domains" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "main_domain.domain", "bolo8774.odns.fr"),
+					resource.TestCheckResourceAttrSet("data.cpanel_domains.domains", "main_domain.document_root"),
+					resource.TestCheckResourceAttrSet("data.cpanel_domains.domains", "main_domain.home_directory"),
+				),
+			},
+		},
+	})
+}
+
+func TestDomainsDataSource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddAddonDomain("client.example.net", "client", "client.example.net")
+	server.AddParkedDomain("example.org")
+	server.AddSubdomain("blog", "example.com", "public_html/blog")
+	server.AddSubdomain("shop", "example.com", "shop")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_domains" "domains" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "main_domain.domain", "example.com"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "main_domain.document_root", "/home/cpaneltest/public_html"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "main_domain.home_directory", "/home/cpaneltest"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "main_domain.php_version", "ea-php81"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "addon_domains.#", "1"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "addon_domains.0.domain", "client.example.net"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "addon_domains.0.document_root", "/home/cpaneltest/client.example.net"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "parked_domains.#", "1"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "parked_domains.0.domain", "example.org"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "parked_domains.0.document_root", "/home/cpaneltest/public_html"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "sub_domains.#", "2"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "sub_domains.0.domain", "blog.example.com"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "sub_domains.0.document_root", "/home/cpaneltest/public_html/blog"),
+					resource.TestCheckResourceAttr("data.cpanel_domains.domains", "sub_domains.1.domain", "shop.example.com"),
+					resource.TestCheckResourceAttrSet("data.cpanel_domains.domains", "last_updated"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+	"time"
+)
+
+type DomainsDataSourceModel struct {
+	MainDomain    DomainsDataSourceDomainModel   `tfsdk:"main_domain"`
+	AddonDomains  []DomainsDataSourceDomainModel `tfsdk:"addon_domains"`
+	ParkedDomains []DomainsDataSourceDomainModel `tfsdk:"parked_domains"`
+	SubDomains    []DomainsDataSourceDomainModel `tfsdk:"sub_domains"`
+	LastUpdated   types.String                   `tfsdk:"last_updated"`
+}
+
+type DomainsDataSourceDomainModel struct {
+	Domain        types.String `tfsdk:"domain"`
+	DocumentRoot  types.String `tfsdk:"document_root"`
+	HomeDirectory types.String `tfsdk:"home_directory"`
+	PHPVersion    types.String `tfsdk:"php_version"`
+}
+
+func DomainsAPIToModel(domainsDataSourceModel *domain.DomainsDataSourceModel) *DomainsDataSourceModel {
+	data := domainsDataSourceModel.Data
+	mainDomain := domainAPIToModel(data.MainDomain)
+
+	// Parked domains serve the website of the main domain
+	parkedDomains := make([]DomainsDataSourceDomainModel, 0, len(data.ParkedDomains))
+	for _, parkedDomain := range data.ParkedDomains {
+		parkedDomainModel := mainDomain
+		parkedDomainModel.Domain = types.StringValue(parkedDomain)
+		parkedDomains = append(parkedDomains, parkedDomainModel)
+	}
+
+	return &DomainsDataSourceModel{
+		MainDomain:    mainDomain,
+		AddonDomains:  domainsAPIToModel(data.AddonDomains),
+		ParkedDomains: parkedDomains,
+		SubDomains:    domainsAPIToModel(data.SubDomains),
+		LastUpdated:   types.StringValue(time.Now().Format(time.RFC3339)),
+	}
+}
+
+func domainsAPIToModel(domains []domain.DomainDataSourceDataModel) []DomainsDataSourceDomainModel {
+	domainModels := make([]DomainsDataSourceDomainModel, 0, len(domains))
+	for _, d := range domains {
+		domainModels = append(domainModels, domainAPIToModel(d))
+	}
+
+	return domainModels
+}
+
+func domainAPIToModel(d domain.DomainDataSourceDataModel) DomainsDataSourceDomainModel {
+	return DomainsDataSourceDomainModel{
+		Domain:        types.StringValue(d.Domain),
+		DocumentRoot:  types.StringValue(d.DocumentRoot),
+		HomeDirectory: types.StringValue(d.HomeDir),
+		PHPVersion:    types.StringValue(d.PHPVersion),
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel/email"
+)
+
+type EmailAccountModel struct {
+	Email            types.String `tfsdk:"email"`
+	Domain           types.String `tfsdk:"domain"`
+	Password         types.String `tfsdk:"password"`
+	QuotaMB          types.Int64  `tfsdk:"quota_mb"`
+	SendWelcomeEmail types.Bool   `tfsdk:"send_welcome_email"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+}
+
+func EmailAccountAPIToModel(accountDataSourceModel *email.AccountDataSourceModel, user, domain string) *EmailAccountModel {
+	for _, data := range accountDataSourceModel.Data {
+		if !strings.EqualFold(data.Email, user+"@"+domain) {
+			continue
+		}
+
+		return &EmailAccountModel{
+			Email:   types.StringValue(user),
+			Domain:  types.StringValue(domain),
+			QuotaMB: types.Int64Value(int64(data.DiskQuota)),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/email"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &emailAccountResource{}
+	_ resource.ResourceWithConfigure   = &emailAccountResource{}
+	_ resource.ResourceWithImportState = &emailAccountResource{}
+)
+
+// NewEmailAccountResource is a helper function to simplify the provider implementation.
+func NewEmailAccountResource() resource.Resource {
+	return &emailAccountResource{}
+}
+
+// emailAccountResource is the resource implementation.
+type emailAccountResource struct {
+	client *email.Client
+}
+
+// Metadata returns the resource type name.
+func (r *emailAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_account"
+}
+
+// Schema defines the schema for the resource.
+func (r *emailAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an email account (mailbox).",
+		MarkdownDescription: "Manages an email account (mailbox).",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required:            true,
+				Description:         "The local part of the email address, before the @.",
+				MarkdownDescription: "The local part of the email address, before the `@`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^@\s]+$`), "must not contain @ or whitespace"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain of the email address.",
+				MarkdownDescription: "The domain of the email address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				Description:         "The account password. cPanel does not return it, so changes made outside Terraform are not detected.",
+				MarkdownDescription: "The account password. cPanel does not return it, so changes made outside Terraform are not detected.",
+			},
+			"quota_mb": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Description:         "The disk quota in megabytes, 0 for unlimited.",
+				MarkdownDescription: "The disk quota in megabytes, `0` for unlimited.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"send_welcome_email": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to send the client configuration instructions to the account on creation.",
+				MarkdownDescription: "Whether to send the client configuration instructions to the account on creation.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *emailAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state EmailAccountModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read email accounts
+	accounts, err := r.client.GetAccounts(state.Domain.ValueString())
+
+	// Remove the account from the state if its domain has been deleted outside Terraform
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting email accounts",
+			"Could not get email accounts, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	account := EmailAccountAPIToModel(accounts, state.Email.ValueString(), state.Domain.ValueString())
+
+	// Remove the account from the state if it has been deleted outside Terraform
+	if account == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.QuotaMB = account.QuotaMB
+	if state.SendWelcomeEmail.IsNull() {
+		state.SendWelcomeEmail = types.BoolValue(false)
+	}
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *emailAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan EmailAccountModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var account email.AccountCreateModel
+	account.Email = plan.Email.ValueString()
+	account.Domain = plan.Domain.ValueString()
+	account.Password = plan.Password.ValueString()
+	account.QuotaMB = plan.QuotaMB.ValueInt64()
+	account.SendWelcomeEmail = plan.SendWelcomeEmail.ValueBool()
+
+	// Create new email account
+	_, err := r.client.CreateAccount(account)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email account",
+			"Could not create email account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *emailAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan EmailAccountModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state EmailAccountModel
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update password
+	if !plan.Password.Equal(state.Password) {
+		var password email.AccountSetPasswordModel
+		password.Email = plan.Email.ValueString()
+		password.Domain = plan.Domain.ValueString()
+		password.Password = plan.Password.ValueString()
+
+		_, err := r.client.SetPassword(password)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating email account",
+				"Could not set email account password, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update quota
+	if !plan.QuotaMB.Equal(state.QuotaMB) {
+		var quota email.AccountSetQuotaModel
+		quota.Email = plan.Email.ValueString()
+		quota.Domain = plan.Domain.ValueString()
+		quota.QuotaMB = plan.QuotaMB.ValueInt64()
+
+		_, err := r.client.SetQuota(quota)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating email account",
+				"Could not set email account quota, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *emailAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state EmailAccountModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var account email.AccountDeleteModel
+	account.Email = state.Email.ValueString()
+	account.Domain = state.Domain.ValueString()
+
+	// Delete existing email account
+	_, err := r.client.DeleteAccount(account)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting email account",
+			"Could not delete email account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the email account from its "user@domain" address.
+func (r *emailAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	user, domain, found := strings.Cut(req.ID, "@")
+	if !found || user == "" || domain == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user@domain. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), user)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *emailAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	emailClient, ok := providerData["email"].(*email.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Email Client Type",
+			fmt.Sprintf("Expected *email.Client, got: %T. Please report this issue to the provider developers.", providerData["email"]),
+		)
+		return
+	}
+
+	r.client = emailClient
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/email"
+)
+
+func TestAccEmailAccountResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_email_account" "account" {
+						email = "tf-acc-account"
+						domain = "bolo8774.odns.fr"
+						password = "kgwFvr4Itufg5Im"
+						quota_mb = 250
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "email", "tf-acc-account"),
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "domain", "bolo8774.odns.fr"),
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "quota_mb", "250"),
+					resource.TestCheckResourceAttrSet("cpanel_email_account.account", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_email_account.account",
+				ImportStateId:                        "tf-acc-account@bolo8774.odns.fr",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+				ImportStateVerifyIgnore:              []string{"password", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_email_account" "account" {
+						email = "tf-acc-account"
+						domain = "bolo8774.odns.fr"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("cpanel_email_account.account", "quota_mb", "0"),
+			},
+		},
+	})
+}
+
+func TestEmailAccountResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_account" "account" {
+						email = "john"
+						domain = "example.com"
+						password = "kgwFvr4Itufg5Im"
+						quota_mb = 250
+						send_welcome_email = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "email", "john"),
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "domain", "example.com"),
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "quota_mb", "250"),
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "send_welcome_email", "true"),
+					resource.TestCheckResourceAttrSet("cpanel_email_account.account", "last_updated"),
+					checkEmailAccount(server, "john@example.com", "kgwFvr4Itufg5Im", 250),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_email_account.account",
+				ImportStateId:                        "john@example.com",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+				ImportStateVerifyIgnore:              []string{"password", "send_welcome_email", "last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() { server.AddEmailAccount("john", "example.com", "kgwFvr4Itufg5Im", 500) },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_account" "account" {
+						email = "john"
+						domain = "example.com"
+						password = "kgwFvr4Itufg5Im"
+						quota_mb = 250
+						send_welcome_email = true
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update password and quota testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_account" "account" {
+						email = "john"
+						domain = "example.com"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+						send_welcome_email = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_email_account.account", "quota_mb", "0"),
+					checkEmailAccount(server, "john@example.com", "KZ8NDJS72JRBDSIZ982NEDNS", 0),
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeleteEmailAccount("john@example.com") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_account" "account" {
+						email = "john"
+						domain = "example.com"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+						send_welcome_email = true
+					}
+				`,
+				Check: checkEmailAccount(server, "john@example.com", "KZ8NDJS72JRBDSIZ982NEDNS", 0),
+			},
+		},
+	})
+}
+
+func TestEmailAccountResourceReadRemovesDeletedAccount(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddEmailAccount("jane", "example.com", "password", 0)
+
+	r := &emailAccountResource{client: email.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &EmailAccountModel{
+		Email:            types.StringValue("john"),
+		Domain:           types.StringValue("example.com"),
+		Password:         types.StringValue("password"),
+		QuotaMB:          types.Int64Value(0),
+		SendWelcomeEmail: types.BoolValue(false),
+		LastUpdated:      types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted email account to be removed from the state")
+	}
+}
+
+func checkEmailAccount(server *cpaneltest.Server, address, expectedPassword string, expectedQuotaMB int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		account := server.EmailAccount(address)
+		if account == nil {
+			return fmt.Errorf("expected the email account %s to exist", address)
+		}
+		if account.Password != expectedPassword {
+			return fmt.Errorf("expected the password of %s to be %q, got %q", address, expectedPassword, account.Password)
+		}
+		if account.QuotaMB != expectedQuotaMB {
+			return fmt.Errorf("expected the quota of %s to be %d, got %d", address, expectedQuotaMB, account.QuotaMB)
+		}
+		return nil
+	}
+}
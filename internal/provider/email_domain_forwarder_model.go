@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel/email"
+)
+
+type EmailDomainForwarderModel struct {
+	Domain            types.String `tfsdk:"domain"`
+	DestinationDomain types.String `tfsdk:"destination_domain"`
+	LastUpdated       types.String `tfsdk:"last_updated"`
+}
+
+func EmailDomainForwarderAPIToModel(domainForwarderDataSourceModel *email.DomainForwarderDataSourceModel, domain string) *EmailDomainForwarderModel {
+	for _, data := range domainForwarderDataSourceModel.Data {
+		if !strings.EqualFold(data.Dest, domain) {
+			continue
+		}
+
+		return &EmailDomainForwarderModel{
+			Domain:            types.StringValue(domain),
+			DestinationDomain: types.StringValue(data.Forward),
+		}
+	}
+
+	return nil
+}
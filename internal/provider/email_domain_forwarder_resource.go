@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/email"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &emailDomainForwarderResource{}
+	_ resource.ResourceWithConfigure   = &emailDomainForwarderResource{}
+	_ resource.ResourceWithImportState = &emailDomainForwarderResource{}
+)
+
+// NewEmailDomainForwarderResource is a helper function to simplify the provider implementation.
+func NewEmailDomainForwarderResource() resource.Resource {
+	return &emailDomainForwarderResource{}
+}
+
+// emailDomainForwarderResource is the resource implementation.
+type emailDomainForwarderResource struct {
+	client *email.Client
+}
+
+// Metadata returns the resource type name.
+func (r *emailDomainForwarderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_domain_forwarder"
+}
+
+// Schema defines the schema for the resource.
+func (r *emailDomainForwarderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Forwards the messages of every address of a domain to the same address at another domain.",
+		MarkdownDescription: "Forwards the messages of every address of a domain to the same address at another domain.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The forwarded domain.",
+				MarkdownDescription: "The forwarded domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination_domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain receiving the messages.",
+				MarkdownDescription: "The domain receiving the messages.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *emailDomainForwarderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state EmailDomainForwarderModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read domain forwarders
+	domainForwarders, err := r.client.GetDomainForwarders()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting email domain forwarders",
+			"Could not get email domain forwarders, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	domainForwarder := EmailDomainForwarderAPIToModel(domainForwarders, state.Domain.ValueString())
+
+	// Remove the domain forwarder from the state if it has been deleted outside Terraform
+	if domainForwarder == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DestinationDomain = domainForwarder.DestinationDomain
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *emailDomainForwarderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan EmailDomainForwarderModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var domainForwarder email.DomainForwarderCreateModel
+	domainForwarder.Domain = plan.Domain.ValueString()
+	domainForwarder.DestDomain = plan.DestinationDomain.ValueString()
+
+	// Create new domain forwarder
+	_, err := r.client.CreateDomainForwarder(domainForwarder)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email domain forwarder",
+			"Could not create email domain forwarder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the domain forwarder to be replaced.
+func (r *emailDomainForwarderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EmailDomainForwarderModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *emailDomainForwarderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state EmailDomainForwarderModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domainForwarder email.DomainForwarderDeleteModel
+	domainForwarder.Domain = state.Domain.ValueString()
+
+	// Delete existing domain forwarder
+	_, err := r.client.DeleteDomainForwarder(domainForwarder)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting email domain forwarder",
+			"Could not delete email domain forwarder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *emailDomainForwarderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *emailDomainForwarderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	emailClient, ok := providerData["email"].(*email.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Email Client Type",
+			fmt.Sprintf("Expected *email.Client, got: %T. Please report this issue to the provider developers.", providerData["email"]),
+		)
+		return
+	}
+
+	r.client = emailClient
+}
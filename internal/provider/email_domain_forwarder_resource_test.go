@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccEmailDomainForwarderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_email_domain_forwarder" "domain_forwarder" {
+						domain = "bolo8774.odns.fr"
+						destination_domain = "example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_email_domain_forwarder.domain_forwarder", "destination_domain", "example.com"),
+					resource.TestCheckResourceAttrSet("cpanel_email_domain_forwarder.domain_forwarder", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_email_domain_forwarder.domain_forwarder",
+				ImportStateId:                        "bolo8774.odns.fr",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestEmailDomainForwarderResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if _, ok := server.EmailDomainForwarder("example.com"); ok {
+				return fmt.Errorf("expected the domain forwarder to be deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_domain_forwarder" "domain_forwarder" {
+						domain = "example.com"
+						destination_domain = "example.org"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_email_domain_forwarder.domain_forwarder", "domain", "example.com"),
+					resource.TestCheckResourceAttr("cpanel_email_domain_forwarder.domain_forwarder", "destination_domain", "example.org"),
+					resource.TestCheckResourceAttrSet("cpanel_email_domain_forwarder.domain_forwarder", "last_updated"),
+					checkEmailDomainForwarder(server, "example.com", "example.org"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_email_domain_forwarder.domain_forwarder",
+				ImportStateId:                        "example.com",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() { server.AddEmailDomainForwarder("example.com", "example.net") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_domain_forwarder" "domain_forwarder" {
+						domain = "example.com"
+						destination_domain = "example.org"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_domain_forwarder" "domain_forwarder" {
+						domain = "example.com"
+						destination_domain = "example.net"
+					}
+				`,
+				Check: checkEmailDomainForwarder(server, "example.com", "example.net"),
+			},
+		},
+	})
+}
+
+func checkEmailDomainForwarder(server *cpaneltest.Server, domain, expectedDestinationDomain string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		destinationDomain, ok := server.EmailDomainForwarder(domain)
+		if !ok || destinationDomain != expectedDestinationDomain {
+			return fmt.Errorf("expected %s to be forwarded to %s, got %q", domain, expectedDestinationDomain, destinationDomain)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel/email"
+)
+
+// Actions of the cpanel_email_forwarder resource.
+const (
+	emailForwarderActionForward   = "forward"
+	emailForwarderActionPipe      = "pipe"
+	emailForwarderActionFail      = "fail"
+	emailForwarderActionBlackhole = "blackhole"
+)
+
+type EmailForwarderModel struct {
+	Email       types.String `tfsdk:"email"`
+	Domain      types.String `tfsdk:"domain"`
+	Action      types.String `tfsdk:"action"`
+	Destination types.String `tfsdk:"destination"`
+	FailMessage types.String `tfsdk:"fail_message"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// EmailForwarderFromForward decodes the forward field returned by list_forwarders.
+func EmailForwarderFromForward(user, domain, forward string) *EmailForwarderModel {
+	forwarder := &EmailForwarderModel{
+		Email:       types.StringValue(user),
+		Domain:      types.StringValue(domain),
+		Action:      types.StringValue(emailForwarderActionForward),
+		Destination: types.StringNull(),
+		FailMessage: types.StringNull(),
+	}
+
+	forward = strings.TrimSpace(forward)
+	switch {
+	case forward == email.ForwardBlackhole:
+		forwarder.Action = types.StringValue(emailForwarderActionBlackhole)
+	case strings.HasPrefix(forward, email.ForwardFailPrefix):
+		forwarder.Action = types.StringValue(emailForwarderActionFail)
+		if message := strings.TrimSpace(strings.TrimPrefix(forward, email.ForwardFailPrefix)); message != "" {
+			forwarder.FailMessage = types.StringValue(message)
+		}
+	case strings.HasPrefix(forward, email.ForwardPipePrefix):
+		forwarder.Action = types.StringValue(emailForwarderActionPipe)
+		forwarder.Destination = types.StringValue(strings.Trim(strings.TrimPrefix(forward, email.ForwardPipePrefix), `"`))
+	default:
+		forwarder.Destination = types.StringValue(forward)
+	}
+
+	return forwarder
+}
+
+// FindEmailForwarder returns the forwarder of the address going to the same
+// destination as the given forwarder, or nil if there is none.
+func FindEmailForwarder(forwarderDataSourceModel *email.ForwarderDataSourceModel, forwarder EmailForwarderModel) *email.ForwarderDataSourceDataModel {
+	address := forwarder.Email.ValueString() + "@" + forwarder.Domain.ValueString()
+
+	for i, data := range forwarderDataSourceModel.Data {
+		if !strings.EqualFold(data.Dest, address) {
+			continue
+		}
+
+		candidate := EmailForwarderFromForward(forwarder.Email.ValueString(), forwarder.Domain.ValueString(), data.Forward)
+		if candidate.Action.Equal(forwarder.Action) &&
+			emailForwarderDestinationMatches(forwarder.Action.ValueString(), candidate.Destination.ValueString(), forwarder.Destination.ValueString()) &&
+			candidate.FailMessage.ValueString() == forwarder.FailMessage.ValueString() {
+			return &forwarderDataSourceModel.Data[i]
+		}
+	}
+
+	return nil
+}
+
+// emailForwarderAction maps the action of the resource to the fwdopt parameter of add_forwarder.
+func emailForwarderAction(action string) string {
+	switch action {
+	case emailForwarderActionPipe:
+		return email.ForwarderActionPipe
+	case emailForwarderActionFail:
+		return email.ForwarderActionFail
+	case emailForwarderActionBlackhole:
+		return email.ForwarderActionBlackhole
+	default:
+		return email.ForwarderActionForward
+	}
+}
+
+// emailForwarderDestinationMatches compares a listed destination with a configured one.
+// cPanel lists programs with their absolute path, while they are configured relative to
+// the home directory.
+func emailForwarderDestinationMatches(action, listed, configured string) bool {
+	if action == emailForwarderActionPipe && !strings.HasPrefix(configured, "/") {
+		return strings.HasSuffix(listed, "/"+configured)
+	}
+
+	if action == emailForwarderActionForward {
+		return strings.EqualFold(listed, configured)
+	}
+
+	return listed == configured
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/email"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &emailForwarderResource{}
+	_ resource.ResourceWithConfigure      = &emailForwarderResource{}
+	_ resource.ResourceWithImportState    = &emailForwarderResource{}
+	_ resource.ResourceWithValidateConfig = &emailForwarderResource{}
+)
+
+// NewEmailForwarderResource is a helper function to simplify the provider implementation.
+func NewEmailForwarderResource() resource.Resource {
+	return &emailForwarderResource{}
+}
+
+// emailForwarderResource is the resource implementation.
+type emailForwarderResource struct {
+	client *email.Client
+}
+
+// Metadata returns the resource type name.
+func (r *emailForwarderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_forwarder"
+}
+
+// Schema defines the schema for the resource.
+func (r *emailForwarderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an email forwarder. An address can have several forwarders, each one is a separate resource.",
+		MarkdownDescription: "Manages an email forwarder. An address can have several forwarders, each one is a separate resource.",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required:            true,
+				Description:         "The local part of the forwarded address, before the @.",
+				MarkdownDescription: "The local part of the forwarded address, before the `@`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^@\s]+$`), "must not contain @ or whitespace"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain of the forwarded address.",
+				MarkdownDescription: "The domain of the forwarded address.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(emailForwarderActionForward),
+				Description:         "What to do with the messages: forward to an address, pipe to a program, fail with an error message, or discard them (blackhole).",
+				MarkdownDescription: "What to do with the messages: `forward` to an address, `pipe` to a program, `fail` with an error message, or discard them (`blackhole`).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(emailForwarderActionForward, emailForwarderActionPipe, emailForwarderActionFail, emailForwarderActionBlackhole),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The address to forward to, or the path of the program to pipe to, relative to the home directory.",
+				MarkdownDescription: "The address to forward to, or the path of the program to pipe to, relative to the home directory. Required by the `forward` and `pipe` actions.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fail_message": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The error message returned to the sender by the fail action.",
+				MarkdownDescription: "The error message returned to the sender by the `fail` action.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the destination and the fail message match the action.
+func (r *emailForwarderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EmailForwarderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Action.IsUnknown() {
+		return
+	}
+
+	action := config.Action.ValueString()
+	if config.Action.IsNull() {
+		action = emailForwarderActionForward
+	}
+
+	switch action {
+	case emailForwarderActionForward, emailForwarderActionPipe:
+		if config.Destination.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("destination"),
+				"Missing Destination",
+				fmt.Sprintf("The %s action requires a destination.", action),
+			)
+		}
+	default:
+		if !config.Destination.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("destination"),
+				"Unexpected Destination",
+				fmt.Sprintf("The %s action does not take a destination.", action),
+			)
+		}
+	}
+
+	if action != emailForwarderActionFail && !config.FailMessage.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fail_message"),
+			"Unexpected Fail Message",
+			fmt.Sprintf("The %s action does not take a fail message.", action),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *emailForwarderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state EmailForwarderModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read forwarders
+	forwarders, err := r.client.GetForwarders(state.Domain.ValueString())
+
+	// Remove the forwarder from the state if its domain has been deleted outside Terraform
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting email forwarders",
+			"Could not get email forwarders, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the forwarder from the state if it has been deleted or changed outside Terraform
+	if FindEmailForwarder(forwarders, state) == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *emailForwarderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan EmailForwarderModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var forwarder email.ForwarderCreateModel
+	forwarder.Email = plan.Email.ValueString()
+	forwarder.Domain = plan.Domain.ValueString()
+	forwarder.Action = emailForwarderAction(plan.Action.ValueString())
+	forwarder.Destination = plan.Destination.ValueString()
+	forwarder.FailMessage = plan.FailMessage.ValueString()
+
+	// Create new forwarder
+	_, err := r.client.CreateForwarder(forwarder)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating email forwarder",
+			"Could not create email forwarder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the forwarder to be replaced.
+func (r *emailForwarderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan EmailForwarderModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *emailForwarderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state EmailForwarderModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// cPanel deletes a forwarder by its destination as listed, which may be quoted
+	forwarders, err := r.client.GetForwarders(state.Domain.ValueString())
+	if errors.Is(err, cpanel.ErrNotFound) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting email forwarders",
+			"Could not get email forwarders, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	existingForwarder := FindEmailForwarder(forwarders, state)
+	if existingForwarder == nil {
+		return
+	}
+
+	var forwarder email.ForwarderDeleteModel
+	forwarder.Address = existingForwarder.Dest
+	forwarder.Forwarder = existingForwarder.Forward
+
+	// Delete existing forwarder
+	_, err = r.client.DeleteForwarder(forwarder)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting email forwarder",
+			"Could not delete email forwarder, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the forwarder from a "user@domain/destination" identifier, the destination
+// being the forward-to address, |program, :fail: message or :blackhole:.
+func (r *emailForwarderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	address, forward, found := strings.Cut(req.ID, "/")
+	user, domain, _ := strings.Cut(address, "@")
+	if !found || user == "" || domain == "" || forward == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user@domain/destination. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, EmailForwarderFromForward(user, domain, forward))...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *emailForwarderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	emailClient, ok := providerData["email"].(*email.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Email Client Type",
+			fmt.Sprintf("Expected *email.Client, got: %T. Please report this issue to the provider developers.", providerData["email"]),
+		)
+		return
+	}
+
+	r.client = emailClient
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccEmailForwarderResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_email_forwarder" "forwarder" {
+						email = "tf-acc-forwarder"
+						domain = "bolo8774.odns.fr"
+						destination = "tf-acc@example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_email_forwarder.forwarder", "action", "forward"),
+					resource.TestCheckResourceAttr("cpanel_email_forwarder.forwarder", "destination", "tf-acc@example.com"),
+					resource.TestCheckResourceAttrSet("cpanel_email_forwarder.forwarder", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_email_forwarder.forwarder",
+				ImportStateId:                        "tf-acc-forwarder@bolo8774.odns.fr/tf-acc@example.com",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestEmailForwarderResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	config := server.ProviderConfig() + `
+		resource "cpanel_email_forwarder" "forward" {
+			email = "john"
+			domain = "example.com"
+			destination = "jane@example.org"
+		}
+
+		resource "cpanel_email_forwarder" "pipe" {
+			email = "john"
+			domain = "example.com"
+			action = "pipe"
+			destination = "bin/mail.php"
+		}
+
+		resource "cpanel_email_forwarder" "fail" {
+			email = "noreply"
+			domain = "example.com"
+			action = "fail"
+			fail_message = "This address does not receive messages."
+		}
+
+		resource "cpanel_email_forwarder" "blackhole" {
+			email = "spam"
+			domain = "example.com"
+			action = "blackhole"
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if forwarders := server.EmailForwarders(); len(forwarders) != 0 {
+				return fmt.Errorf("expected the forwarders to be deleted, got: %v", forwarders)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_email_forwarder.forward", "action", "forward"),
+					resource.TestCheckResourceAttrSet("cpanel_email_forwarder.forward", "last_updated"),
+					checkEmailForwarders(server,
+						cpaneltest.EmailForwarder{Address: "john@example.com", Forward: "jane@example.org"},
+						cpaneltest.EmailForwarder{Address: "john@example.com", Forward: "|/home/cpaneltest/bin/mail.php"},
+						cpaneltest.EmailForwarder{Address: "noreply@example.com", Forward: ":fail: This address does not receive messages."},
+						cpaneltest.EmailForwarder{Address: "spam@example.com", Forward: ":blackhole:"},
+					),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_email_forwarder.fail",
+				ImportStateId:                        "noreply@example.com/:fail: This address does not receive messages.",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			{
+				ResourceName:                         "cpanel_email_forwarder.blackhole",
+				ImportStateId:                        "spam@example.com/:blackhole:",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "email",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig:          func() { server.DeleteEmailForwarder("john@example.com", "jane@example.org") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  checkEmailForwarders(server, cpaneltest.EmailForwarder{Address: "john@example.com", Forward: "jane@example.org"}),
+			},
+		},
+	})
+}
+
+func TestEmailForwarderResourceInvalidConfig(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_forwarder" "forwarder" {
+						email = "john"
+						domain = "example.com"
+						action = "pipe"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The pipe action requires a destination`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_email_forwarder" "forwarder" {
+						email = "john"
+						domain = "example.com"
+						action = "blackhole"
+						destination = "jane@example.org"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`The blackhole action does not take a destination`),
+			},
+		},
+	})
+}
+
+func checkEmailForwarders(server *cpaneltest.Server, expectedForwarders ...cpaneltest.EmailForwarder) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		forwarders := server.EmailForwarders()
+		for _, forwarder := range expectedForwarders {
+			if !slices.Contains(forwarders, forwarder) {
+				return fmt.Errorf("expected the forwarder %v, got: %v", forwarder, forwarders)
+			}
+		}
+		return nil
+	}
+}
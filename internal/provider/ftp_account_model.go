@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel/ftp"
+)
+
+type FTPAccountModel struct {
+	User        types.String `tfsdk:"user"`
+	Domain      types.String `tfsdk:"domain"`
+	Password    types.String `tfsdk:"password"`
+	HomeDir     types.String `tfsdk:"homedir"`
+	Quota       types.Int64  `tfsdk:"quota"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+func FTPAccountAPIToModel(account *ftp.AccountDataSourceDataModel, user, domain string) *FTPAccountModel {
+	return &FTPAccountModel{
+		User:    types.StringValue(user),
+		Domain:  types.StringValue(domain),
+		HomeDir: types.StringValue(account.RelDir),
+		Quota:   types.Int64Value(int64(account.DiskQuota)),
+	}
+}
+
+// FTPHomeDirEqual reports whether two home directories are the same once normalized as
+// cPanel does, without leading and trailing slashes.
+func FTPHomeDirEqual(a, b string) bool {
+	return strings.Trim(a, "/") == strings.Trim(b, "/")
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/ftp"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &ftpAccountResource{}
+	_ resource.ResourceWithConfigure   = &ftpAccountResource{}
+	_ resource.ResourceWithImportState = &ftpAccountResource{}
+)
+
+// NewFTPAccountResource is a helper function to simplify the provider implementation.
+func NewFTPAccountResource() resource.Resource {
+	return &ftpAccountResource{}
+}
+
+// ftpAccountResource is the resource implementation.
+type ftpAccountResource struct {
+	client *ftp.Client
+}
+
+// Metadata returns the resource type name.
+func (r *ftpAccountResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ftp_account"
+}
+
+// Schema defines the schema for the resource.
+func (r *ftpAccountResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an FTP account. The files of its home directory are kept when it is deleted.",
+		MarkdownDescription: "Manages an FTP account. The files of its home directory are kept when it is deleted.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Required:            true,
+				Description:         "The FTP username, before the @ of the login.",
+				MarkdownDescription: "The FTP username, before the `@` of the login.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^@\s]+$`), "must not contain @ or whitespace"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain of the login.",
+				MarkdownDescription: "The domain of the login.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				Description:         "The account password. cPanel does not return it, so changes made outside Terraform are not detected.",
+				MarkdownDescription: "The account password. cPanel does not return it, so changes made outside Terraform are not detected.",
+			},
+			"homedir": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The directory the account is restricted to, relative to the home directory. Defaults to a directory named after the user in public_html.",
+				MarkdownDescription: "The directory the account is restricted to, relative to the home directory. Defaults to a directory named after the user in `public_html`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quota": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Description:         "The disk quota in megabytes, 0 for unlimited.",
+				MarkdownDescription: "The disk quota in megabytes, `0` for unlimited.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *ftpAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state FTPAccountModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read FTP account
+	account, err := r.client.GetAccount(state.User.ValueString(), state.Domain.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting FTP account",
+			"Could not get FTP account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the account from the state if it has been deleted outside Terraform
+	if account == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model := FTPAccountAPIToModel(account, state.User.ValueString(), state.Domain.ValueString())
+	// Keep the configured notation of the home directory
+	if state.HomeDir.IsNull() || !FTPHomeDirEqual(state.HomeDir.ValueString(), model.HomeDir.ValueString()) {
+		state.HomeDir = model.HomeDir
+	}
+	state.Quota = model.Quota
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *ftpAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan FTPAccountModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var account ftp.AccountCreateModel
+	account.User = plan.User.ValueString()
+	account.Domain = plan.Domain.ValueString()
+	account.Password = plan.Password.ValueString()
+	account.HomeDir = plan.HomeDir.ValueString()
+	account.QuotaMB = plan.Quota.ValueInt64()
+
+	// Create new FTP account
+	_, err := r.client.CreateAccount(account)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating FTP account",
+			"Could not create FTP account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Read the home directory chosen by cPanel
+	createdAccount, err := r.client.GetAccount(account.User, account.Domain)
+
+	if err == nil && createdAccount == nil {
+		err = errors.New("the created FTP account was not found")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting FTP account",
+			"Could not get FTP account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if plan.HomeDir.IsUnknown() || !FTPHomeDirEqual(plan.HomeDir.ValueString(), createdAccount.RelDir) {
+		plan.HomeDir = types.StringValue(createdAccount.RelDir)
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *ftpAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan FTPAccountModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state FTPAccountModel
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update password
+	if !plan.Password.Equal(state.Password) {
+		var password ftp.AccountSetPasswordModel
+		password.User = plan.User.ValueString()
+		password.Domain = plan.Domain.ValueString()
+		password.Password = plan.Password.ValueString()
+
+		_, err := r.client.SetPassword(password)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating FTP account",
+				"Could not set FTP account password, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update quota
+	if !plan.Quota.Equal(state.Quota) {
+		var quota ftp.AccountSetQuotaModel
+		quota.User = plan.User.ValueString()
+		quota.Domain = plan.Domain.ValueString()
+		quota.QuotaMB = plan.Quota.ValueInt64()
+
+		_, err := r.client.SetQuota(quota)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating FTP account",
+				"Could not set FTP account quota, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *ftpAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state FTPAccountModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var account ftp.AccountDeleteModel
+	account.User = state.User.ValueString()
+	account.Domain = state.Domain.ValueString()
+
+	// Delete existing FTP account
+	_, err := r.client.DeleteAccount(account)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting FTP account",
+			"Could not delete FTP account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the FTP account from its "user@domain" login.
+func (r *ftpAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	user, domain, found := strings.Cut(req.ID, "@")
+	if !found || user == "" || domain == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user@domain. Got: %q", req.ID),
+		)
+		return
+	}
+
+	account, err := r.client.GetAccount(user, domain)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting FTP account",
+			"Could not get FTP account, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if account == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the login of an existing FTP account. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, FTPAccountAPIToModel(account, user, domain))...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *ftpAccountResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	ftpClient, ok := providerData["ftp"].(*ftp.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected FTP Client Type",
+			fmt.Sprintf("Expected *ftp.Client, got: %T. Please report this issue to the provider developers.", providerData["ftp"]),
+		)
+		return
+	}
+
+	r.client = ftpClient
+}
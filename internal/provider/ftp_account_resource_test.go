@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/ftp"
+)
+
+func TestAccFTPAccountResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_ftp_account" "account" {
+						user = "tf-acc-ftp"
+						domain = "bolo8774.odns.fr"
+						password = "kgwFvr4Itufg5Im"
+						quota = 250
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "user", "tf-acc-ftp"),
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "quota", "250"),
+					resource.TestCheckResourceAttrSet("cpanel_ftp_account.account", "homedir"),
+					resource.TestCheckResourceAttrSet("cpanel_ftp_account.account", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_ftp_account.account",
+				ImportStateId:                        "tf-acc-ftp@bolo8774.odns.fr",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user",
+				ImportStateVerifyIgnore:              []string{"password", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_ftp_account" "account" {
+						user = "tf-acc-ftp"
+						domain = "bolo8774.odns.fr"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("cpanel_ftp_account.account", "quota", "0"),
+			},
+		},
+	})
+}
+
+func TestFTPAccountResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if server.FTPAccount("agency@example.com") != nil {
+				return fmt.Errorf("expected the FTP account agency@example.com to be deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_ftp_account" "account" {
+						user = "agency"
+						domain = "example.com"
+						password = "kgwFvr4Itufg5Im"
+						quota = 250
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "user", "agency"),
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "domain", "example.com"),
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "homedir", "public_html/agency"),
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "quota", "250"),
+					resource.TestCheckResourceAttrSet("cpanel_ftp_account.account", "last_updated"),
+					checkFTPAccount(server, "agency@example.com", "kgwFvr4Itufg5Im", "public_html/agency", 250),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_ftp_account.account",
+				ImportStateId:                        "agency@example.com",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user",
+				ImportStateVerifyIgnore:              []string{"password", "last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					server.AddFTPAccount("agency", "example.com", "kgwFvr4Itufg5Im", "public_html/agency", 500)
+				},
+				Config: server.ProviderConfig() + `
+					resource "cpanel_ftp_account" "account" {
+						user = "agency"
+						domain = "example.com"
+						password = "kgwFvr4Itufg5Im"
+						quota = 250
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Password rotation and quota update testing, in place
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_ftp_account" "account" {
+						user = "agency"
+						domain = "example.com"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "quota", "0"),
+					checkFTPAccount(server, "agency@example.com", "KZ8NDJS72JRBDSIZ982NEDNS", "public_html/agency", 0),
+					func(_ *terraform.State) error {
+						for _, call := range server.Calls() {
+							if call.Function == "delete_ftp" {
+								return fmt.Errorf("expected the FTP account to be updated in place")
+							}
+						}
+						return nil
+					},
+				),
+			},
+			// Replace testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_ftp_account" "account" {
+						user = "agency"
+						domain = "example.com"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+						homedir = "uploads/agency"
+					}
+				`,
+				Check: checkFTPAccount(server, "agency@example.com", "KZ8NDJS72JRBDSIZ982NEDNS", "uploads/agency", 0),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeleteFTPAccount("agency@example.com") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_ftp_account" "account" {
+						user = "agency"
+						domain = "example.com"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+						homedir = "uploads/agency"
+					}
+				`,
+				Check: checkFTPAccount(server, "agency@example.com", "KZ8NDJS72JRBDSIZ982NEDNS", "uploads/agency", 0),
+			},
+		},
+	})
+}
+
+func TestFTPAccountResourceHomeDirNotation(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	config := server.ProviderConfig() + `
+		resource "cpanel_ftp_account" "account" {
+			user = "agency"
+			domain = "example.com"
+			password = "KZ8NDJS72JRBDSIZ982NEDNS"
+			homedir = "/uploads/agency/"
+		}
+	`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ftp_account.account", "homedir", "/uploads/agency/"),
+					checkFTPAccount(server, "agency@example.com", "KZ8NDJS72JRBDSIZ982NEDNS", "uploads/agency", 0),
+				),
+			},
+			// The account is not replaced after refreshing the normalized home directory
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestFTPAccountResourceReadRemovesDeletedAccount(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddFTPAccount("other", "example.com", "password", "public_html/other", 0)
+
+	r := &ftpAccountResource{client: ftp.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &FTPAccountModel{
+		User:        types.StringValue("agency"),
+		Domain:      types.StringValue("example.com"),
+		Password:    types.StringValue("password"),
+		HomeDir:     types.StringValue("public_html/agency"),
+		Quota:       types.Int64Value(0),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted FTP account to be removed from the state")
+	}
+}
+
+func checkFTPAccount(server *cpaneltest.Server, login, expectedPassword, expectedHomeDir string, expectedQuotaMB int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		account := server.FTPAccount(login)
+		if account == nil {
+			return fmt.Errorf("expected the FTP account %s to exist", login)
+		}
+		if account.Password != expectedPassword {
+			return fmt.Errorf("expected the password of %s to be %q, got %q", login, expectedPassword, account.Password)
+		}
+		if account.HomeDir != expectedHomeDir {
+			return fmt.Errorf("expected the home directory of %s to be %q, got %q", login, expectedHomeDir, account.HomeDir)
+		}
+		if account.QuotaMB != expectedQuotaMB {
+			return fmt.Errorf("expected the quota of %s to be %d, got %d", login, expectedQuotaMB, account.QuotaMB)
+		}
+		return nil
+	}
+}
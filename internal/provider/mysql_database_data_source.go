@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &mySQLDatabaseDataSource{}
+	_ datasource.DataSourceWithConfigure = &mySQLDatabaseDataSource{}
+)
+
+// NewMySQLDatabaseDataSource is a helper function to simplify the provider implementation.
+func NewMySQLDatabaseDataSource() datasource.DataSource {
+	return &mySQLDatabaseDataSource{}
+}
+
+// mySQLDatabaseDataSource is the data source implementation.
+type mySQLDatabaseDataSource struct {
+	client *mysql.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *mySQLDatabaseDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	mysqlClient, ok := providerData["mysql"].(*mysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected MySQL Client Type",
+			fmt.Sprintf("Expected *mysql.Client, got: %T. Please report this issue to the provider developers.", providerData["mysql"]),
+		)
+		return
+	}
+
+	d.client = mysqlClient
+}
+
+// Metadata returns the data source type name.
+func (d *mySQLDatabaseDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_database"
+}
+
+// Schema defines the schema for the data source.
+func (d *mySQLDatabaseDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The database name.",
+				MarkdownDescription: "The database name.",
+			},
+			"users": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The users granted privileges on the database.",
+				MarkdownDescription: "The users granted privileges on the database.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *mySQLDatabaseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config MySQLDatabaseDataSourceModel
+
+	// Read Terraform configuration data into the state
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	databases, err := d.client.GetDatabases()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read MySQL databases: %s", err),
+			err.Error(),
+		)
+		return
+	}
+
+	state := MySQLDatabaseAPIToModel(databases, config.Name.ValueString())
+
+	if state == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read MySQL database from name: %s", config.Name),
+			"",
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
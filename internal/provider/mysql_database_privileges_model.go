@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+)
+
+type MySQLDatabasePrivilegesModel struct {
+	Database    types.String   `tfsdk:"database"`
+	User        types.String   `tfsdk:"user"`
+	Privileges  []types.String `tfsdk:"privileges"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+}
+
+// MySQLPrivilegesAPIToModel returns the granted privileges. As cPanel reports every privilege granted one
+// by one as ALL PRIVILEGES, it is expanded to the individual privileges unless known is ALL PRIVILEGES.
+func MySQLPrivilegesAPIToModel(privilegesDataSourceModel *mysql.PrivilegesDataSourceModel, known []types.String) []types.String {
+	granted := privilegesDataSourceModel.Data
+	if len(granted) == 1 && granted[0] == mysql.AllPrivileges && len(known) > 0 && !slices.Contains(known, types.StringValue(mysql.AllPrivileges)) {
+		granted = mysql.Privileges
+	}
+
+	privileges := make([]types.String, 0, len(granted))
+	for _, privilege := range granted {
+		privileges = append(privileges, types.StringValue(privilege))
+	}
+
+	return privileges
+}
+
+func MySQLPrivilegesModelToAPI(privileges []types.String) []string {
+	result := make([]string, 0, len(privileges))
+	for _, privilege := range privileges {
+		result = append(result, privilege.ValueString())
+	}
+
+	return result
+}
@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &mySQLDatabasePrivilegesResource{}
+	_ resource.ResourceWithConfigure      = &mySQLDatabasePrivilegesResource{}
+	_ resource.ResourceWithImportState    = &mySQLDatabasePrivilegesResource{}
+	_ resource.ResourceWithValidateConfig = &mySQLDatabasePrivilegesResource{}
+)
+
+// NewMySQLDatabasePrivilegesResource is a helper function to simplify the provider implementation.
+func NewMySQLDatabasePrivilegesResource() resource.Resource {
+	return &mySQLDatabasePrivilegesResource{}
+}
+
+// mySQLDatabasePrivilegesResource is the resource implementation.
+type mySQLDatabasePrivilegesResource struct {
+	client *mysql.Client
+}
+
+// Metadata returns the resource type name.
+func (r *mySQLDatabasePrivilegesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_database_privileges"
+}
+
+// Schema defines the schema for the resource.
+func (r *mySQLDatabasePrivilegesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the privileges of a MySQL user on a database.",
+		MarkdownDescription: "Manages the privileges of a MySQL user on a database.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required:            true,
+				Description:         "The database name.",
+				MarkdownDescription: "The database name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:            true,
+				Description:         "The user name.",
+				MarkdownDescription: "The user name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privileges": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The privileges granted to the user on the database, or ALL PRIVILEGES alone.",
+				MarkdownDescription: "The privileges granted to the user on the database (`SELECT`, `INSERT`, `ALTER`, ...), or `ALL PRIVILEGES` alone.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(append([]string{mysql.AllPrivileges}, mysql.Privileges...)...)),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects ALL PRIVILEGES combined with other privileges.
+func (r *mySQLDatabasePrivilegesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var privileges types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("privileges"), &privileges)...)
+	if resp.Diagnostics.HasError() || privileges.IsUnknown() || len(privileges.Elements()) < 2 {
+		return
+	}
+
+	for _, privilege := range privileges.Elements() {
+		if privilege.Equal(types.StringValue(mysql.AllPrivileges)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("privileges"),
+				"Invalid Privileges",
+				mysql.AllPrivileges+" cannot be combined with other privileges.",
+			)
+			return
+		}
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mySQLDatabasePrivilegesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state MySQLDatabasePrivilegesModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read privileges
+	var query mysql.PrivilegesQueryModel
+	query.Database = state.Database.ValueString()
+	query.User = state.User.ValueString()
+
+	mySQLPrivileges, err := r.client.GetPrivileges(query)
+
+	// Remove the privileges from the state if the user or the database has been deleted outside Terraform
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting privileges",
+			"Could not get privileges, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the privileges from the state if they have been revoked outside Terraform
+	if len(mySQLPrivileges.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Privileges = MySQLPrivilegesAPIToModel(mySQLPrivileges, state.Privileges)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *mySQLDatabasePrivilegesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan MySQLDatabasePrivilegesModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Grant privileges
+	err := r.setPrivileges(plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating privileges",
+			"Could not create privileges, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *mySQLDatabasePrivilegesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan MySQLDatabasePrivilegesModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace privileges
+	err := r.setPrivileges(plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating privileges",
+			"Could not update privileges, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *mySQLDatabasePrivilegesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state MySQLDatabasePrivilegesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var privileges mysql.PrivilegesRevokeModel
+	privileges.Database = state.Database.ValueString()
+	privileges.User = state.User.ValueString()
+
+	// Revoke existing privileges
+	_, err := r.client.RevokePrivileges(privileges)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting privileges",
+			"Could not delete privileges, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the privileges from a "database/user" identifier.
+func (r *mySQLDatabasePrivilegesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	database, user, found := strings.Cut(req.ID, "/")
+	if !found || database == "" || user == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/user. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), user)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mySQLDatabasePrivilegesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	mysqlClient, ok := providerData["mysql"].(*mysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected MySQL Client Type",
+			fmt.Sprintf("Expected *mysql.Client, got: %T. Please report this issue to the provider developers.", providerData["mysql"]),
+		)
+		return
+	}
+
+	r.client = mysqlClient
+}
+
+func (r *mySQLDatabasePrivilegesResource) setPrivileges(plan MySQLDatabasePrivilegesModel) error {
+	var privileges mysql.PrivilegesSetModel
+	privileges.Database = plan.Database.ValueString()
+	privileges.User = plan.User.ValueString()
+	privileges.Privileges = MySQLPrivilegesModelToAPI(plan.Privileges)
+
+	_, err := r.client.SetPrivileges(privileges)
+
+	return err
+}
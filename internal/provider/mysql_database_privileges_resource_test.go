@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+)
+
+func TestAccMySQLDatabasePrivilegesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_mysql_database_privileges" "privileges" {
+						database = "sc1bolo8774_mysql_database_read"
+						user = "sc1bolo8774_mysql_user_read"
+						privileges = ["SELECT", "SHOW VIEW"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database_privileges.privileges", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("cpanel_mysql_database_privileges.privileges", "privileges.*", "SELECT"),
+					resource.TestCheckTypeSetElemAttr("cpanel_mysql_database_privileges.privileges", "privileges.*", "SHOW VIEW"),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_database_privileges.privileges", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_mysql_database_privileges.privileges",
+				ImportStateId:                        "sc1bolo8774_mysql_database_read/sc1bolo8774_mysql_user_read",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "database",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_mysql_database_privileges" "privileges" {
+						database = "sc1bolo8774_mysql_database_read"
+						user = "sc1bolo8774_mysql_user_read"
+						privileges = ["ALL PRIVILEGES"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database_privileges.privileges", "privileges.#", "1"),
+					resource.TestCheckTypeSetElemAttr("cpanel_mysql_database_privileges.privileges", "privileges.*", "ALL PRIVILEGES"),
+				),
+			},
+		},
+	})
+}
+
+func TestMySQLDatabasePrivilegesResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddMySQLUser("user_report", "password")
+	server.AddMySQLDatabase("user_database")
+
+	config := func(privileges string) string {
+		return server.ProviderConfig() + `
+			resource "cpanel_mysql_database_privileges" "privileges" {
+				database = "user_database"
+				user = "user_report"
+				privileges = ` + privileges + `
+			}
+		`
+	}
+
+	allPrivileges := `["` + strings.Join(mysql.Privileges, `", "`) + `"]`
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(`["SELECT", "SHOW VIEW"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database_privileges.privileges", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("cpanel_mysql_database_privileges.privileges", "privileges.*", "SELECT"),
+					resource.TestCheckTypeSetElemAttr("cpanel_mysql_database_privileges.privileges", "privileges.*", "SHOW VIEW"),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_database_privileges.privileges", "last_updated"),
+					checkMySQLPrivileges(server, "user_database", "user_report", "SELECT", "SHOW VIEW"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_mysql_database_privileges.privileges",
+				ImportStateId:                        "user_database/user_report",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "database",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig:          func() { server.SetMySQLPrivileges("user_database", "user_report", "SELECT", "SHOW VIEW", "DROP") },
+				Config:             config(`["SELECT", "SHOW VIEW"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config(`["SELECT", "SHOW VIEW"]`),
+				Check:  checkMySQLPrivileges(server, "user_database", "user_report", "SELECT", "SHOW VIEW"),
+			},
+			// Every privilege listed one by one, reported by cPanel as ALL PRIVILEGES
+			{
+				Config: config(allPrivileges),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database_privileges.privileges", "privileges.#", strconv.Itoa(len(mysql.Privileges))),
+					checkMySQLPrivileges(server, "user_database", "user_report", "ALL PRIVILEGES"),
+				),
+			},
+			{
+				Config:             config(allPrivileges),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Update and Read testing
+			{
+				Config: config(`["ALL PRIVILEGES"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database_privileges.privileges", "privileges.#", "1"),
+					resource.TestCheckTypeSetElemAttr("cpanel_mysql_database_privileges.privileges", "privileges.*", "ALL PRIVILEGES"),
+					checkMySQLPrivileges(server, "user_database", "user_report", "ALL PRIVILEGES"),
+				),
+			},
+			// Re-create after revocation outside Terraform
+			{
+				PreConfig: func() { server.SetMySQLPrivileges("user_database", "user_report") },
+				Config:    config(`["ALL PRIVILEGES"]`),
+				Check:     checkMySQLPrivileges(server, "user_database", "user_report", "ALL PRIVILEGES"),
+			},
+		},
+	})
+
+	if privileges := server.MySQLDatabase("user_database").Privileges; len(privileges) != 0 {
+		t.Fatalf("expected the privileges to be revoked on destroy, got: %v", privileges)
+	}
+}
+
+func TestMySQLDatabasePrivilegesResourceInvalidPrivileges(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_database_privileges" "privileges" {
+						database = "user_database"
+						user = "user_report"
+						privileges = ["ALL PRIVILEGES", "SELECT"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`cannot be combined with other privileges`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_database_privileges" "privileges" {
+						database = "user_database"
+						user = "user_report"
+						privileges = ["SELECT", "GRANT"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func TestMySQLDatabasePrivilegesResourceReadRemovesDeletedUser(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddMySQLDatabase("user_database")
+
+	r := &mySQLDatabasePrivilegesResource{client: mysql.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &MySQLDatabasePrivilegesModel{
+		Database:    types.StringValue("user_database"),
+		User:        types.StringValue("user_deleted"),
+		Privileges:  []types.String{types.StringValue("SELECT")},
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the privileges of the deleted user to be removed from the state")
+	}
+}
+
+func checkMySQLPrivileges(server *cpaneltest.Server, database, user string, expectedPrivileges ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		privileges := slices.Clone(server.MySQLDatabase(database).Privileges[user])
+		slices.Sort(privileges)
+		expectedPrivileges = slices.Clone(expectedPrivileges)
+		slices.Sort(expectedPrivileges)
+		if !slices.Equal(privileges, expectedPrivileges) {
+			return fmt.Errorf("expected the privileges of %s on %s to be %v, got %v", user, database, expectedPrivileges, privileges)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &mySQLDatabaseResource{}
+	_ resource.ResourceWithConfigure   = &mySQLDatabaseResource{}
+	_ resource.ResourceWithImportState = &mySQLDatabaseResource{}
+)
+
+// NewMySQLDatabaseResource is a helper function to simplify the provider implementation.
+func NewMySQLDatabaseResource() resource.Resource {
+	return &mySQLDatabaseResource{}
+}
+
+// mySQLDatabaseResource is the resource implementation.
+type mySQLDatabaseResource struct {
+	client *mysql.Client
+}
+
+// Metadata returns the resource type name.
+func (r *mySQLDatabaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_database"
+}
+
+// Schema defines the schema for the resource.
+func (r *mySQLDatabaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The database name, prefixed with the cPanel account name.",
+				MarkdownDescription: "The database name, prefixed with the cPanel account name.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mySQLDatabaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state MySQLDatabaseModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read databases
+	databases, err := r.client.GetDatabases()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting databases",
+			"Could not get databases, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	database := MySQLDatabaseAPIToModel(databases, state.Name.ValueString())
+
+	// Remove the database from the state if it has been deleted outside Terraform
+	if database == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = database.Name
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = database.LastUpdated
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *mySQLDatabaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan MySQLDatabaseModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var database mysql.DatabaseCreateModel
+	database.Name = plan.Name.ValueString()
+
+	// Create new database
+	_, err := r.client.CreateDatabase(database)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating database",
+			"Could not create database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Name = types.StringValue(database.Name)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *mySQLDatabaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan MySQLDatabaseModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MySQLDatabaseModel
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var database mysql.DatabaseUpdateModel
+	database.OldName = state.Name.ValueString()
+	database.NewName = plan.Name.ValueString()
+
+	// Update database
+	if database.OldName != database.NewName {
+		_, err := r.client.UpdateDatabase(database)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating database",
+				"Could not update database, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Name = types.StringValue(database.NewName)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *mySQLDatabaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state MySQLDatabaseModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var database mysql.DatabaseDeleteModel
+	database.Name = state.Name.ValueString()
+
+	// Delete existing database
+	_, err := r.client.DeleteDatabase(database)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting database",
+			"Could not delete database, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *mySQLDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mySQLDatabaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	mysqlClient, ok := providerData["mysql"].(*mysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected MySQL Client Type",
+			fmt.Sprintf("Expected *mysql.Client, got: %T. Please report this issue to the provider developers.", providerData["mysql"]),
+		)
+		return
+	}
+
+	r.client = mysqlClient
+}
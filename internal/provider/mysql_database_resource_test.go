@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+)
+
+func TestAccMySQLDatabaseResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_mysql_database" "database" {
+						name = "sc1bolo8774_mysql_database_create"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database.database", "name", "sc1bolo8774_mysql_database_create"),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_database.database", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_mysql_database.database",
+				ImportStateId:                        "sc1bolo8774_mysql_database_create",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_mysql_database" "database" {
+						name = "sc1bolo8774_mysql_database_update"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database.database", "name", "sc1bolo8774_mysql_database_update"),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_database.database", "last_updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestMySQLDatabaseResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_database" "database" {
+						name = "user_database"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database.database", "name", "user_database"),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_database.database", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_mysql_database.database",
+				ImportStateId:                        "user_database",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_database" "database" {
+						name = "user_database_renamed"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_database.database", "name", "user_database_renamed"),
+					func(_ *terraform.State) error {
+						if server.MySQLDatabase("user_database") != nil || server.MySQLDatabase("user_database_renamed") == nil {
+							return fmt.Errorf("expected the database to be renamed")
+						}
+						return nil
+					},
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeleteMySQLDatabase("user_database_renamed") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_database" "database" {
+						name = "user_database_renamed"
+					}
+				`,
+				Check: func(_ *terraform.State) error {
+					if server.MySQLDatabase("user_database_renamed") == nil {
+						return fmt.Errorf("expected the database to be re-created")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestMySQLDatabaseResourceReadRemovesDeletedDatabase(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddMySQLDatabase("user_other")
+
+	r := &mySQLDatabaseResource{client: mysql.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &MySQLDatabaseModel{
+		Name:        types.StringValue("user_deleted"),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted database to be removed from the state")
+	}
+}
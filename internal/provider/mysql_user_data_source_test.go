@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccMySQLUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "cpanel_mysql_user" "user_read" {
+					name = "sc1bolo8774_mysql_user_read"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_mysql_user.user_read", "name", "sc1bolo8774_mysql_user_read"),
+					resource.TestCheckResourceAttr("data.cpanel_mysql_user.user_read", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.cpanel_mysql_user.user_read", "databases.0", "sc1bolo8774_mysql_database_read"),
+				),
+			},
+		},
+	})
+}
+
+func TestMySQLUserDataSource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddMySQLUser("user_read", "password")
+	server.AddMySQLDatabase("user_database_read", "user_read")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_mysql_user" "user_read" {
+					name = "user_read"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_mysql_user.user_read", "name", "user_read"),
+					resource.TestCheckResourceAttr("data.cpanel_mysql_user.user_read", "databases.#", "1"),
+					resource.TestCheckResourceAttr("data.cpanel_mysql_user.user_read", "databases.0", "user_database_read"),
+				),
+			},
+		},
+	})
+}
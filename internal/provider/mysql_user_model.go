@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+	"time"
+)
+
+type MySQLUserModel struct {
+	Name        types.String `tfsdk:"name"`
+	Password    types.String `tfsdk:"password"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+type MySQLUserDataSourceModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Databases   []types.String `tfsdk:"databases"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+}
+
+func MySQLUserAPIToModel(userDataSourceModel *mysql.UserDataSourceModel, name string) *MySQLUserDataSourceModel {
+	for _, data := range userDataSourceModel.Data {
+		if data.User != name {
+			continue
+		}
+
+		databases := make([]types.String, 0, len(data.Databases))
+		for _, database := range data.Databases {
+			databases = append(databases, types.StringValue(database))
+		}
+
+		return &MySQLUserDataSourceModel{
+			Name:        types.StringValue(data.User),
+			Databases:   databases,
+			LastUpdated: types.StringValue(time.Now().Format(time.RFC3339)),
+		}
+	}
+
+	return nil
+}
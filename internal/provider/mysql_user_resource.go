@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &mySQLUserResource{}
+	_ resource.ResourceWithConfigure   = &mySQLUserResource{}
+	_ resource.ResourceWithImportState = &mySQLUserResource{}
+)
+
+// NewMySQLUserResource is a helper function to simplify the provider implementation.
+func NewMySQLUserResource() resource.Resource {
+	return &mySQLUserResource{}
+}
+
+// mySQLUserResource is the resource implementation.
+type mySQLUserResource struct {
+	client *mysql.Client
+}
+
+// Metadata returns the resource type name.
+func (r *mySQLUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mysql_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *mySQLUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The user name, prefixed with the cPanel account name.",
+				MarkdownDescription: "The user name, prefixed with the cPanel account name.",
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				Description:         "The user password.",
+				MarkdownDescription: "The user password.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mySQLUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state MySQLUserModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read users
+	mySQLUserDataSource, err := r.client.GetUsers()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting users",
+			"Could not get users, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	user := MySQLUserAPIToModel(mySQLUserDataSource, state.Name.ValueString())
+
+	// Remove the user from the state if it has been deleted outside Terraform
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = user.Name
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = user.LastUpdated
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *mySQLUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan MySQLUserModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var user mysql.UserCreateModel
+	user.Name = plan.Name.ValueString()
+	user.Password = plan.Password.ValueString()
+
+	// Create new user
+	_, err := r.client.CreateUser(user)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user",
+			"Could not create user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *mySQLUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan MySQLUserModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state MySQLUserModel
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Rename user, MySQL keeps the password on rename
+	if state.Name.ValueString() != plan.Name.ValueString() {
+		var userRename mysql.UserRenameModel
+		userRename.OldName = state.Name.ValueString()
+		userRename.NewName = plan.Name.ValueString()
+
+		_, err := r.client.RenameUser(userRename)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating user",
+				"Could not rename user, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Update password
+	if state.Password.ValueString() != plan.Password.ValueString() {
+		var userSetPassword mysql.UserSetPasswordModel
+		userSetPassword.User = plan.Name.ValueString()
+		userSetPassword.Password = plan.Password.ValueString()
+
+		_, err := r.client.SetPassword(userSetPassword)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating user",
+				"Could not set user password, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *mySQLUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state MySQLUserModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user mysql.UserDeleteModel
+	user.Name = state.Name.ValueString()
+
+	// Delete existing user
+	_, err := r.client.DeleteUser(user)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting user",
+			"Could not delete user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *mySQLUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mySQLUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	mysqlClient, ok := providerData["mysql"].(*mysql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected MySQL Client Type",
+			fmt.Sprintf("Expected *mysql.Client, got: %T. Please report this issue to the provider developers.", providerData["mysql"]),
+		)
+		return
+	}
+
+	r.client = mysqlClient
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
+)
+
+func TestAccMySQLUserResource(t *testing.T) {
+	var password = "kgwFvr4Itufg5Im"
+	var passwordNew = "KZ8NDJS72JRBDSIZ982NEDNS"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_mysql_user" "user" {
+						name = "sc1bolo8774_mysql_user_create"
+						password = "` + password + `"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_user.user", "name", "sc1bolo8774_mysql_user_create"),
+					resource.TestCheckResourceAttr("cpanel_mysql_user.user", "password", password),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_user.user", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_mysql_user.user",
+				ImportStateId:                        "sc1bolo8774_mysql_user_create",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"password", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_mysql_user" "user" {
+						name = "sc1bolo8774_mysql_user_update"
+						password = "` + passwordNew + `"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_user.user", "name", "sc1bolo8774_mysql_user_update"),
+					resource.TestCheckResourceAttr("cpanel_mysql_user.user", "password", passwordNew),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_user.user", "last_updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestMySQLUserResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_user" "user" {
+						name = "user_create"
+						password = "kgwFvr4Itufg5Im"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_user.user", "name", "user_create"),
+					resource.TestCheckResourceAttrSet("cpanel_mysql_user.user", "last_updated"),
+					checkMySQLUserPassword(server, "user_create", "kgwFvr4Itufg5Im"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_mysql_user.user",
+				ImportStateId:                        "user_create",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"password", "last_updated"},
+			},
+			// Rename and update password testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_user" "user" {
+						name = "user_update"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_mysql_user.user", "name", "user_update"),
+					checkMySQLUserPassword(server, "user_update", "KZ8NDJS72JRBDSIZ982NEDNS"),
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeleteMySQLUser("user_update") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_mysql_user" "user" {
+						name = "user_update"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: checkMySQLUserPassword(server, "user_update", "KZ8NDJS72JRBDSIZ982NEDNS"),
+			},
+		},
+	})
+}
+
+func TestMySQLUserResourceReadRemovesDeletedUser(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddMySQLUser("user_other", "password")
+
+	r := &mySQLUserResource{client: mysql.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &MySQLUserModel{
+		Name:        types.StringValue("user_deleted"),
+		Password:    types.StringValue("password"),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted user to be removed from the state")
+	}
+}
+
+func checkMySQLUserPassword(server *cpaneltest.Server, name, expectedPassword string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		password, ok := server.MySQLUserPassword(name)
+		if !ok {
+			return fmt.Errorf("expected the user %s to exist", name)
+		}
+		if password != expectedPassword {
+			return fmt.Errorf("expected the password of %s to be %q, got %q", name, expectedPassword, password)
+		}
+		return nil
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
 )
 
 func TestAccPostgreSQLDatabaseDataSource(t *testing.T) {
@@ -25,3 +26,27 @@ func TestAccPostgreSQLDatabaseDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestPostgreSQLDatabaseDataSource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddPostgreSQLUser("user_read", "password")
+	server.AddPostgreSQLDatabase("user_database_read", "user_read")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_postgresql_database" "database_read" {
+					name = "user_database_read"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_postgresql_database.database_read", "name", "user_database_read"),
+					resource.TestCheckResourceAttr("data.cpanel_postgresql_database.database_read", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.cpanel_postgresql_database.database_read", "users.0", "user_read"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"slices"
+	"terraform-provider-cpanel/internal/cpanel/postgresql"
+)
+
+type PostgreSQLDatabaseGrantModel struct {
+	Database    types.String `tfsdk:"database"`
+	User        types.String `tfsdk:"user"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+func PostgreSQLDatabaseGrantAPIToModel(databaseDataSourceModel *postgresql.DatabaseDataSourceModel, database, user string) *PostgreSQLDatabaseGrantModel {
+	for _, data := range databaseDataSourceModel.Data {
+		if data.Database != database || !slices.Contains(data.Users, user) {
+			continue
+		}
+
+		return &PostgreSQLDatabaseGrantModel{
+			Database: types.StringValue(data.Database),
+			User:     types.StringValue(user),
+		}
+	}
+
+	return nil
+}
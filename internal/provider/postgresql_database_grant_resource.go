@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/postgresql"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &postgreSQLDatabaseGrantResource{}
+	_ resource.ResourceWithConfigure   = &postgreSQLDatabaseGrantResource{}
+	_ resource.ResourceWithImportState = &postgreSQLDatabaseGrantResource{}
+)
+
+// NewPostgreSQLDatabaseGrantResource is a helper function to simplify the provider implementation.
+func NewPostgreSQLDatabaseGrantResource() resource.Resource {
+	return &postgreSQLDatabaseGrantResource{}
+}
+
+// postgreSQLDatabaseGrantResource is the resource implementation.
+type postgreSQLDatabaseGrantResource struct {
+	client *postgresql.Client
+}
+
+// Metadata returns the resource type name.
+func (r *postgreSQLDatabaseGrantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_postgresql_database_grant"
+}
+
+// Schema defines the schema for the resource.
+func (r *postgreSQLDatabaseGrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Grants all privileges on a PostgreSQL database to a user.",
+		MarkdownDescription: "Grants all privileges on a PostgreSQL database to a user.",
+		Attributes: map[string]schema.Attribute{
+			"database": schema.StringAttribute{
+				Required:            true,
+				Description:         "The database name.",
+				MarkdownDescription: "The database name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:            true,
+				Description:         "The user name.",
+				MarkdownDescription: "The user name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *postgreSQLDatabaseGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state PostgreSQLDatabaseGrantModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read databases
+	databases, err := r.client.GetDatabases()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting databases",
+			"Could not get databases, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	grant := PostgreSQLDatabaseGrantAPIToModel(databases, state.Database.ValueString(), state.User.ValueString())
+
+	// Remove the grant from the state if it has been revoked outside Terraform
+	if grant == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *postgreSQLDatabaseGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan PostgreSQLDatabaseGrantModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request parameters from plan
+	var grantAllPrivileges postgresql.UserGrantAllPrivilegesModel
+	grantAllPrivileges.Database = plan.Database.ValueString()
+	grantAllPrivileges.User = plan.User.ValueString()
+
+	// Grant all privileges
+	_, err := r.client.GrantAllPrivileges(grantAllPrivileges)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error granting all privileges",
+			"Could not grant all privileges, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the grant to be replaced.
+func (r *postgreSQLDatabaseGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PostgreSQLDatabaseGrantModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *postgreSQLDatabaseGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state PostgreSQLDatabaseGrantModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var revokeAllPrivileges postgresql.UserRevokeAllPrivilegesModel
+	revokeAllPrivileges.Database = state.Database.ValueString()
+	revokeAllPrivileges.User = state.User.ValueString()
+
+	// Revoke all privileges
+	_, err := r.client.RevokeAllPrivileges(revokeAllPrivileges)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error revoking all privileges",
+			"Could not revoke all privileges, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the grant from a "database/user" identifier.
+func (r *postgreSQLDatabaseGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	database, user, found := strings.Cut(req.ID, "/")
+	if !found || database == "" || user == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: database/user. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("database"), database)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), user)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *postgreSQLDatabaseGrantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	postgresqlClient, ok := providerData["postgresql"].(*postgresql.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected PostgreSQL Client Type",
+			fmt.Sprintf("Expected *postgresql.Client, got: %T. Please report this issue to the provider developers.", providerData["postgresql"]),
+		)
+		return
+	}
+
+	r.client = postgresqlClient
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/postgresql"
+)
+
+func TestAccPostgreSQLDatabaseGrantResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_postgresql_database" "database" {
+						name = "sc1bolo8774_database_grant"
+					}
+
+					resource "cpanel_postgresql_database_grant" "grant" {
+						database = cpanel_postgresql_database.database.name
+						user = "sc1bolo8774_user_read"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_postgresql_database_grant.grant", "database", "sc1bolo8774_database_grant"),
+					resource.TestCheckResourceAttr("cpanel_postgresql_database_grant.grant", "user", "sc1bolo8774_user_read"),
+					resource.TestCheckResourceAttrSet("cpanel_postgresql_database_grant.grant", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_postgresql_database_grant.grant",
+				ImportStateId:                        "sc1bolo8774_database_grant/sc1bolo8774_user_read",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "database",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestPostgreSQLDatabaseGrantResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddPostgreSQLUser("user_read", "password")
+	server.AddPostgreSQLUser("user_write", "password")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_database" "database" {
+						name = "user_database"
+					}
+
+					resource "cpanel_postgresql_database_grant" "read" {
+						database = cpanel_postgresql_database.database.name
+						user = "user_read"
+					}
+
+					resource "cpanel_postgresql_database_grant" "write" {
+						database = cpanel_postgresql_database.database.name
+						user = "user_write"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_postgresql_database_grant.read", "database", "user_database"),
+					resource.TestCheckResourceAttr("cpanel_postgresql_database_grant.read", "user", "user_read"),
+					resource.TestCheckResourceAttrSet("cpanel_postgresql_database_grant.read", "last_updated"),
+					resource.TestCheckNoResourceAttr("cpanel_postgresql_database.database", "users.#"),
+					checkPostgreSQLDatabaseUsers(server, "user_database", "user_read", "user_write"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_postgresql_database_grant.read",
+				ImportStateId:                        "user_database/user_read",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "user",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Revoke testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_database" "database" {
+						name = "user_database"
+					}
+
+					resource "cpanel_postgresql_database_grant" "read" {
+						database = cpanel_postgresql_database.database.name
+						user = "user_read"
+					}
+				`,
+				Check: checkPostgreSQLDatabaseUsers(server, "user_database", "user_read"),
+			},
+		},
+	})
+}
+
+func TestPostgreSQLDatabaseGrantResourceReadRemovesRevokedGrant(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddPostgreSQLUser("user_read", "password")
+	server.AddPostgreSQLDatabase("user_database")
+
+	r := &postgreSQLDatabaseGrantResource{client: postgresql.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &PostgreSQLDatabaseGrantModel{
+		Database:    types.StringValue("user_database"),
+		User:        types.StringValue("user_read"),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the revoked grant to be removed from the state")
+	}
+}
+
+func checkPostgreSQLDatabaseUsers(server *cpaneltest.Server, name string, expectedUsers ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		database := server.PostgreSQLDatabase(name)
+		if database == nil {
+			return fmt.Errorf("expected the database %s to exist", name)
+		}
+
+		users := slices.Clone(database.Users)
+		slices.Sort(users)
+		if !slices.Equal(users, expectedUsers) {
+			return fmt.Errorf("expected the database %s to be granted to %v, got %v", name, expectedUsers, users)
+		}
+		return nil
+	}
+}
@@ -2,13 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"slices"
-	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
 	"terraform-provider-cpanel/internal/cpanel/postgresql"
 	"terraform-provider-cpanel/internal/utils"
 	"time"
@@ -47,9 +48,10 @@ func (r *postgreSQLDatabaseResource) Schema(_ context.Context, _ resource.Schema
 			},
 			"users": schema.ListAttribute{
 				ElementType:         types.StringType,
-				Required:            true,
-				Description:         "The database users.",
-				MarkdownDescription: "The database users.",
+				Optional:            true,
+				Description:         "The database users. When omitted, the grants of the database are not managed by this resource.",
+				MarkdownDescription: "The database users. When omitted, the grants of the database are not managed by this resource.",
+				DeprecationMessage:  "Use the cpanel_postgresql_database_grant resource to grant users on the database instead.",
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
@@ -79,7 +81,20 @@ func (r *postgreSQLDatabaseResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	state = *PostgreSQLDatabaseAPIToModel(databases, state.Name.ValueString())
+	database := PostgreSQLDatabaseAPIToModel(databases, state.Name.ValueString())
+
+	// Remove the database from the state if it has been deleted outside Terraform
+	if database == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Leave the grants to cpanel_postgresql_database_grant resources when users are not managed here
+	if state.Users == nil {
+		database.Users = nil
+	}
+
+	state = *database
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -105,7 +120,7 @@ func (r *postgreSQLDatabaseResource) Create(ctx context.Context, req resource.Cr
 	database.Name = plan.Name.ValueString()
 
 	// Create new database
-	postgreSQLDatabaseDataSourceModel, err := r.client.CreateDatabase(database)
+	_, err := r.client.CreateDatabase(database)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -114,13 +129,6 @@ func (r *postgreSQLDatabaseResource) Create(ctx context.Context, req resource.Cr
 		)
 		return
 	}
-	if postgreSQLDatabaseDataSourceModel.Status != 1 {
-		resp.Diagnostics.AddError(
-			"Error creating database",
-			"Could not create database, got errors: ["+strings.Join(postgreSQLDatabaseDataSourceModel.Errors, ", ")+"]",
-		)
-		return
-	}
 
 	var users []types.String
 
@@ -128,7 +136,7 @@ func (r *postgreSQLDatabaseResource) Create(ctx context.Context, req resource.Cr
 		var grantAllPrivileges postgresql.UserGrantAllPrivilegesModel
 		grantAllPrivileges.Database = database.Name
 		grantAllPrivileges.User = user.ValueString()
-		postgresqlUserDataSourceModel, err := r.client.GrantAllPrivileges(grantAllPrivileges)
+		_, err := r.client.GrantAllPrivileges(grantAllPrivileges)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -138,14 +146,6 @@ func (r *postgreSQLDatabaseResource) Create(ctx context.Context, req resource.Cr
 			return
 		}
 
-		if postgresqlUserDataSourceModel.Status != 1 {
-			resp.Diagnostics.AddError(
-				"Error granting all privileges",
-				"Could not grant all privileges, got errors: ["+strings.Join(postgresqlUserDataSourceModel.Errors, ", ")+"]",
-			)
-			return
-		}
-
 		users = append(users, user)
 	}
 
@@ -196,7 +196,7 @@ func (r *postgreSQLDatabaseResource) Update(ctx context.Context, req resource.Up
 
 	// Update database
 	if database.OldName != database.NewName {
-		postgreSQLDatabaseDataSourceModel, err := r.client.UpdateDatabase(database)
+		_, err := r.client.UpdateDatabase(database)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -205,14 +205,25 @@ func (r *postgreSQLDatabaseResource) Update(ctx context.Context, req resource.Up
 			)
 			return
 		}
+	}
 
-		if postgreSQLDatabaseDataSourceModel.Status != 1 {
-			resp.Diagnostics.AddError(
-				"Error updating database",
-				"Could not update database, got errors: ["+strings.Join(postgreSQLDatabaseDataSourceModel.Errors, ", ")+"]",
-			)
-			return
-		}
+	// Leave the grants to cpanel_postgresql_database_grant resources when users are not managed here
+	if plan.Users == nil {
+		plan.Name = types.StringValue(database.NewName)
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+		diags = resp.State.Set(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	existingUsers, err := r.client.GetUsers()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting users",
+			"Could not get users, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
 	var users = []types.String{}
@@ -220,9 +231,7 @@ func (r *postgreSQLDatabaseResource) Update(ctx context.Context, req resource.Up
 	for _, user := range plan.Users {
 		users = append(users, user)
 
-		userExists, _ := r.client.UserExists(user.ValueString())
-
-		if !userExists {
+		if !slices.Contains(existingUsers.Data, user.ValueString()) {
 			resp.Diagnostics.AddError(
 				"User does not exist",
 				fmt.Sprintf("User does not exist: %s. Create a postgreSQL user resource first.", user.ValueString()),
@@ -237,7 +246,7 @@ func (r *postgreSQLDatabaseResource) Update(ctx context.Context, req resource.Up
 		var grantAllPrivileges postgresql.UserGrantAllPrivilegesModel
 		grantAllPrivileges.Database = database.NewName
 		grantAllPrivileges.User = user.ValueString()
-		postgresqlUserDataSourceModel, err := r.client.GrantAllPrivileges(grantAllPrivileges)
+		_, err := r.client.GrantAllPrivileges(grantAllPrivileges)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -246,27 +255,17 @@ func (r *postgreSQLDatabaseResource) Update(ctx context.Context, req resource.Up
 			)
 			return
 		}
-
-		if postgresqlUserDataSourceModel.Status != 1 {
-			resp.Diagnostics.AddError(
-				"Error granting all privileges",
-				"Could not grant all privileges, got errors: ["+strings.Join(postgresqlUserDataSourceModel.Errors, ", ")+"]",
-			)
-			return
-		}
 	}
 
 	for _, user := range state.Users {
-		userExists, _ := r.client.UserExists(user.ValueString())
-
-		if slices.Contains(plan.Users, user) || !userExists {
+		if slices.Contains(plan.Users, user) || !slices.Contains(existingUsers.Data, user.ValueString()) {
 			continue
 		}
 
 		var revokeAllPrivileges postgresql.UserRevokeAllPrivilegesModel
 		revokeAllPrivileges.Database = database.NewName
 		revokeAllPrivileges.User = user.ValueString()
-		postgresqlUserDataSourceModel, err := r.client.RevokeAllPrivileges(revokeAllPrivileges)
+		_, err := r.client.RevokeAllPrivileges(revokeAllPrivileges)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -275,14 +274,6 @@ func (r *postgreSQLDatabaseResource) Update(ctx context.Context, req resource.Up
 			)
 			return
 		}
-
-		if postgresqlUserDataSourceModel.Status != 1 {
-			resp.Diagnostics.AddError(
-				"Error revoking all privileges",
-				"Could not revoke all privileges, got errors: ["+strings.Join(postgresqlUserDataSourceModel.Errors, ", ")+"]",
-			)
-			return
-		}
 	}
 
 	plan.Name = types.StringValue(database.NewName)
@@ -311,22 +302,15 @@ func (r *postgreSQLDatabaseResource) Delete(ctx context.Context, req resource.De
 	database.Name = state.Name.ValueString()
 
 	// Delete existing database
-	postgreSQLDatabaseDataSourceModel, err := r.client.DeleteDatabase(database)
+	_, err := r.client.DeleteDatabase(database)
 
-	if err != nil {
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleting database",
 			"Could not deleting database, unexpected error: "+err.Error(),
 		)
 		return
 	}
-	if postgreSQLDatabaseDataSourceModel.Status != 1 {
-		resp.Diagnostics.AddError(
-			"Error deleting database",
-			"Could not deleting database, got errors: ["+strings.Join(postgreSQLDatabaseDataSourceModel.Errors, ", ")+"]",
-		)
-		return
-	}
 }
 
 func (r *postgreSQLDatabaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
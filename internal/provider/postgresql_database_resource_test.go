@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/postgresql"
 )
 
 func TestAccPostgreSQLDatabaseResource(t *testing.T) {
@@ -40,7 +47,7 @@ func TestAccPostgreSQLDatabaseResource(t *testing.T) {
 				ImportState:                          true,
 				ImportStateVerify:                    true,
 				ImportStateVerifyIdentifierAttribute: "name",
-				ImportStateVerifyIgnore:              []string{"last_updated"},
+				ImportStateVerifyIgnore:              []string{"users", "last_updated"},
 			},
 			// Update and Read testing
 			{
@@ -65,3 +72,116 @@ func TestAccPostgreSQLDatabaseResource(t *testing.T) {
 		},
 	})
 }
+
+func TestPostgreSQLDatabaseResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddPostgreSQLUser("user_read", "password")
+	server.AddPostgreSQLUser("user_new", "password")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_database" "database" {
+						name = "user_database"
+						users = ["user_read"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_postgresql_database.database", "name", "user_database"),
+					resource.TestCheckResourceAttr("cpanel_postgresql_database.database", "users.#", "1"),
+					resource.TestCheckResourceAttr("cpanel_postgresql_database.database", "users.0", "user_read"),
+					resource.TestCheckResourceAttrSet("cpanel_postgresql_database.database", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_postgresql_database.database",
+				ImportStateId:                        "user_database",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"users", "last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_database" "database" {
+						name = "user_database_renamed"
+						users = ["user_new"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_postgresql_database.database", "name", "user_database_renamed"),
+					resource.TestCheckResourceAttr("cpanel_postgresql_database.database", "users.#", "1"),
+					resource.TestCheckResourceAttr("cpanel_postgresql_database.database", "users.0", "user_new"),
+					func(_ *terraform.State) error {
+						database := server.PostgreSQLDatabase("user_database_renamed")
+						if database == nil || len(database.Users) != 1 || database.Users[0] != "user_new" {
+							return fmt.Errorf("expected the database to be renamed and granted to user_new, got: %+v", database)
+						}
+						return nil
+					},
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeletePostgreSQLDatabase("user_database_renamed") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_database" "database" {
+						name = "user_database_renamed"
+						users = ["user_new"]
+					}
+				`,
+				Check: func(_ *terraform.State) error {
+					if server.PostgreSQLDatabase("user_database_renamed") == nil {
+						return fmt.Errorf("expected the database to be re-created")
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestPostgreSQLDatabaseResourceReadRemovesDeletedDatabase(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddPostgreSQLDatabase("user_other")
+
+	r := &postgreSQLDatabaseResource{client: postgresql.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &PostgreSQLDatabaseModel{
+		Name:        types.StringValue("user_deleted"),
+		Users:       []types.String{types.StringValue("user_read")},
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted database to be removed from the state")
+	}
+}
+
+func TestPostgreSQLDatabaseResourceCreateError(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.InjectFault(cpanel.ModulePostgresql, "create_database", cpaneltest.Fault{
+		Errors: []string{"The database “user_database” exceeds the quota."},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_database" "database" {
+						name = "user_database"
+						users = []
+					}
+				`,
+				ExpectError: regexp.MustCompile(`exceeds the quota`),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
 )
 
 func TestAccPostgreSQLUserDataSource(t *testing.T) {
@@ -23,3 +24,25 @@ func TestAccPostgreSQLUserDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestPostgreSQLUserDataSource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddPostgreSQLUser("user_read", "password")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_postgresql_user" "user_read" {
+					name = "user_read"
+					password = "password"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_postgresql_user.user_read", "name", "user_read"),
+				),
+			},
+		},
+	})
+}
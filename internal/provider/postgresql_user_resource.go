@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
 	"terraform-provider-cpanel/internal/cpanel/postgresql"
 	"time"
 )
@@ -77,17 +78,15 @@ func (r *postgreSQLUserResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	var currentUser string
+	user := PostgreSQLUserAPIToModel(postgreSQLUserDataSource, state.Name.ValueString())
 
-	for _, user := range postgreSQLUserDataSource.Data {
-		if user != state.Name.ValueString() {
-			continue
-		}
-
-		currentUser = user
+	// Remove the user from the state if it has been deleted outside Terraform
+	if user == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	state.Name = types.StringValue(currentUser)
+	state.Name = user.Name
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -114,7 +113,7 @@ func (r *postgreSQLUserResource) Create(ctx context.Context, req resource.Create
 	user.Password = plan.Password.ValueString()
 
 	// Create new database
-	postgreSQLUserDataSourceModel, err := r.client.CreateUser(user)
+	_, err := r.client.CreateUser(user)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -123,13 +122,6 @@ func (r *postgreSQLUserResource) Create(ctx context.Context, req resource.Create
 		)
 		return
 	}
-	if postgreSQLUserDataSourceModel.Status != 1 {
-		resp.Diagnostics.AddError(
-			"Error creating user",
-			"Could not create user, got errors: ["+strings.Join(postgreSQLUserDataSourceModel.Errors, ", ")+"]",
-		)
-		return
-	}
 
 	plan.Name = types.StringValue(user.Name)
 	plan.Password = types.StringValue(user.Password)
@@ -173,13 +165,12 @@ func (r *postgreSQLUserResource) Update(ctx context.Context, req resource.Update
 	userSetPassword.Password = plan.Password.ValueString()
 
 	// Update user
-	var postgreSQLUserDataSourceModel *postgresql.UserDataSourceModel
 	var err error
 
 	if userRename.OldName != userRename.NewName {
-		postgreSQLUserDataSourceModel, err = r.client.RenameUser(userRename)
+		_, err = r.client.RenameUser(userRename)
 	} else {
-		postgreSQLUserDataSourceModel, err = r.client.SetPassword(userSetPassword)
+		_, err = r.client.SetPassword(userSetPassword)
 	}
 
 	if err != nil {
@@ -190,14 +181,6 @@ func (r *postgreSQLUserResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	if postgreSQLUserDataSourceModel.Status != 1 {
-		resp.Diagnostics.AddError(
-			"Error updating user",
-			"Could not update user, got errors: ["+strings.Join(postgreSQLUserDataSourceModel.Errors, ", ")+"]",
-		)
-		return
-	}
-
 	plan.Name = types.StringValue(userRename.NewName)
 	plan.Password = types.StringValue(userRename.Password)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -224,22 +207,15 @@ func (r *postgreSQLUserResource) Delete(ctx context.Context, req resource.Delete
 	user.Name = state.Name.ValueString()
 
 	// Delete existing user
-	postgreSQLUserDataSourceModel, err := r.client.DeleteUser(user)
+	_, err := r.client.DeleteUser(user)
 
-	if err != nil {
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
 		resp.Diagnostics.AddError(
 			"Error deleting user",
 			"Could not deleting user, unexpected error: "+err.Error(),
 		)
 		return
 	}
-	if postgreSQLUserDataSourceModel.Status != 1 {
-		resp.Diagnostics.AddError(
-			"Error deleting user",
-			"Could not deleting user, got errors: ["+strings.Join(postgreSQLUserDataSourceModel.Errors, ", ")+"]",
-		)
-		return
-	}
 }
 
 func (r *postgreSQLUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/postgresql"
 )
 
 func TestAccPostgreSQLUserResource(t *testing.T) {
@@ -61,3 +66,100 @@ func TestAccPostgreSQLUserResource(t *testing.T) {
 		},
 	})
 }
+
+func TestPostgreSQLUserResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_user" "user" {
+						name = "user_create"
+						password = "kgwFvr4Itufg5Im"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_postgresql_user.user", "name", "user_create"),
+					resource.TestCheckResourceAttrSet("cpanel_postgresql_user.user", "last_updated"),
+					checkPostgreSQLUserPassword(server, "user_create", "kgwFvr4Itufg5Im"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_postgresql_user.user",
+				ImportStateId:                        "user_create",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"password", "last_updated"},
+			},
+			// Update password testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_user" "user" {
+						name = "user_create"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: checkPostgreSQLUserPassword(server, "user_create", "KZ8NDJS72JRBDSIZ982NEDNS"),
+			},
+			// Rename testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_user" "user" {
+						name = "user_update"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_postgresql_user.user", "name", "user_update"),
+					checkPostgreSQLUserPassword(server, "user_update", "KZ8NDJS72JRBDSIZ982NEDNS"),
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeletePostgreSQLUser("user_update") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_postgresql_user" "user" {
+						name = "user_update"
+						password = "KZ8NDJS72JRBDSIZ982NEDNS"
+					}
+				`,
+				Check: checkPostgreSQLUserPassword(server, "user_update", "KZ8NDJS72JRBDSIZ982NEDNS"),
+			},
+		},
+	})
+}
+
+func TestPostgreSQLUserResourceReadRemovesDeletedUser(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddPostgreSQLUser("user_other", "password")
+
+	r := &postgreSQLUserResource{client: postgresql.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &PostgreSQLUserModel{
+		Name:        types.StringValue("user_deleted"),
+		Password:    types.StringValue("password"),
+		LastUpdated: types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted user to be removed from the state")
+	}
+}
+
+func checkPostgreSQLUserPassword(server *cpaneltest.Server, name, expectedPassword string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		password, ok := server.PostgreSQLUserPassword(name)
+		if !ok {
+			return fmt.Errorf("expected the user %s to exist", name)
+		}
+		if password != expectedPassword {
+			return fmt.Errorf("expected the password of %s to be %q, got %q", name, expectedPassword, password)
+		}
+		return nil
+	}
+}
@@ -2,17 +2,33 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"os"
+	"strconv"
 	"terraform-provider-cpanel/internal/cpanel"
 	"terraform-provider-cpanel/internal/cpanel/cron"
+	"terraform-provider-cpanel/internal/cpanel/dns"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+	"terraform-provider-cpanel/internal/cpanel/email"
+	"terraform-provider-cpanel/internal/cpanel/ftp"
+	"terraform-provider-cpanel/internal/cpanel/mysql"
 	"terraform-provider-cpanel/internal/cpanel/postgresql"
+	"terraform-provider-cpanel/internal/cpanel/ssh"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+	"terraform-provider-cpanel/internal/durationvalidator"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -57,6 +73,64 @@ func (p *cpanelProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 			"host": schema.StringAttribute{
 				Optional: true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of times a request failing with a transient error (429, 502, 503, 504, connection reset or timeout) is retried. Defaults to 3.",
+				MarkdownDescription: "The number of times a request failing with a transient error (429, 502, 503, 504, connection reset or timeout) is retried. Defaults to `3`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The maximum wait between two retries, as a duration such as 30s or 1m. Defaults to 30s.",
+				MarkdownDescription: "The maximum wait between two retries, as a duration such as `30s` or `1m`. Defaults to `30s`.",
+				Validators: []validator.String{
+					durationvalidator.Positive(),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The time limit of a single request, as a duration such as 10s or 1m. Defaults to 10s.",
+				MarkdownDescription: "The time limit of a single request, as a duration such as `10s` or `1m`. Defaults to `10s`.",
+				Validators: []validator.String{
+					durationvalidator.Positive(),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path of a PEM encoded CA bundle trusted on top of the system certificates.",
+				MarkdownDescription: "The path of a PEM encoded CA bundle trusted on top of the system certificates.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A PEM encoded CA bundle trusted on top of the system certificates.",
+				MarkdownDescription: "A PEM encoded CA bundle trusted on top of the system certificates.",
+			},
+			"client_cert_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path of a PEM encoded client certificate presented to cPanel.",
+				MarkdownDescription: "The path of a PEM encoded client certificate presented to cPanel.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The path of the PEM encoded key of the client certificate.",
+				MarkdownDescription: "The path of the PEM encoded key of the client certificate.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Disable the verification of the cPanel certificate, for instance on freshly provisioned servers using a self-signed certificate. Defaults to false.",
+				MarkdownDescription: "Disable the verification of the cPanel certificate, for instance on freshly provisioned servers using a self-signed certificate. Defaults to `false`.",
+			},
 		},
 	}
 }
@@ -100,6 +174,46 @@ func (p *cpanelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 	}
 
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown cpanel API Max Retries",
+			"The provider cannot create the cpanel API client as there is an unknown configuration value for the cpanel API max retries. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the CPANEL_MAX_RETRIES environment variable.",
+		)
+	}
+
+	if config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Unknown cpanel API Retry Max Wait",
+			"The provider cannot create the cpanel API client as there is an unknown configuration value for the cpanel API retry max wait. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the CPANEL_RETRY_MAX_WAIT environment variable.",
+		)
+	}
+
+	for _, attribute := range []struct {
+		name   string
+		value  attr.Value
+		envVar string
+	}{
+		{"request_timeout", config.RequestTimeout, "CPANEL_REQUEST_TIMEOUT"},
+		{"ca_cert_file", config.CACertFile, "CPANEL_CA_CERT_FILE"},
+		{"ca_cert_pem", config.CACertPEM, "CPANEL_CA_CERT_PEM"},
+		{"client_cert_file", config.ClientCertFile, "CPANEL_CLIENT_CERT_FILE"},
+		{"client_key_file", config.ClientKeyFile, "CPANEL_CLIENT_KEY_FILE"},
+		{"insecure_skip_verify", config.InsecureSkipVerify, "CPANEL_INSECURE_SKIP_VERIFY"},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown cpanel API TLS Configuration",
+				"The provider cannot create the cpanel API client as there is an unknown configuration value for "+attribute.name+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the "+attribute.envVar+" environment variable.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -123,6 +237,63 @@ func (p *cpanelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		host = config.Host.ValueString()
 	}
 
+	maxRetries := int64(cpanel.DefaultMaxRetries)
+	if value := os.Getenv("CPANEL_MAX_RETRIES"); value != "" {
+		parsedMaxRetries, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsedMaxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid cpanel API Max Retries",
+				"The CPANEL_MAX_RETRIES environment variable must be a non-negative integer, got: "+value,
+			)
+		}
+		maxRetries = parsedMaxRetries
+	}
+
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+
+	retryMaxWait := os.Getenv("CPANEL_RETRY_MAX_WAIT")
+
+	if !config.RetryMaxWait.IsNull() {
+		retryMaxWait = config.RetryMaxWait.ValueString()
+	}
+
+	retryMaxWaitDuration := cpanel.DefaultRetryMaxWait
+	if retryMaxWait != "" {
+		parsedRetryMaxWait, err := time.ParseDuration(retryMaxWait)
+		if err != nil || parsedRetryMaxWait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid cpanel API Retry Max Wait",
+				"The retry max wait must be a positive duration such as 30s or 1m, got: "+retryMaxWait,
+			)
+		}
+		retryMaxWaitDuration = parsedRetryMaxWait
+	}
+
+	requestTimeout := os.Getenv("CPANEL_REQUEST_TIMEOUT")
+
+	if !config.RequestTimeout.IsNull() {
+		requestTimeout = config.RequestTimeout.ValueString()
+	}
+
+	requestTimeoutDuration := cpanel.DefaultRequestTimeout
+	if requestTimeout != "" {
+		parsedRequestTimeout, err := time.ParseDuration(requestTimeout)
+		if err != nil || parsedRequestTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid cpanel API Request Timeout",
+				"The request timeout must be a positive duration such as 10s or 1m, got: "+requestTimeout,
+			)
+		}
+		requestTimeoutDuration = parsedRequestTimeout
+	}
+
+	tlsOptions := tlsOptionsFromConfig(config, &resp.Diagnostics)
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -166,8 +337,16 @@ func (p *cpanelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	tflog.Info(ctx, "Creating cpanel client")
 
+	if tlsOptions.InsecureSkipVerify {
+		tflog.Warn(ctx, "The cpanel API certificate will not be verified")
+	}
+
 	// Create a new cpanel client using the configuration values
-	client, err := cpanel.NewClient(&host, &username, &apiToken)
+	client, err := cpanel.NewClient(&host, &username, &apiToken,
+		cpanel.WithRetry(int(maxRetries), retryMaxWaitDuration),
+		cpanel.WithRequestTimeout(requestTimeoutDuration),
+		cpanel.WithTLS(tlsOptions),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create cpanel API Client",
@@ -180,17 +359,38 @@ func (p *cpanelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	// Initialize module clients
 	cronClient := cron.NewClient(client)
+	dnsClient := dns.NewClient(client)
+	domainClient := domain.NewClient(client)
+	emailClient := email.NewClient(client)
+	ftpClient := ftp.NewClient(client)
+	mySQLClient := mysql.NewClient(client)
 	postgreSQLClient := postgresql.NewClient(client)
+	sshClient := ssh.NewClient(client)
+	sslClient := ssl.NewClient(client)
 
 	// Make the module clients available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = map[string]interface{}{
 		"cron":       cronClient,
+		"dns":        dnsClient,
+		"domain":     domainClient,
+		"email":      emailClient,
+		"ftp":        ftpClient,
+		"mysql":      mySQLClient,
 		"postgresql": postgreSQLClient,
+		"ssh":        sshClient,
+		"ssl":        sslClient,
 	}
 	resp.ResourceData = map[string]interface{}{
 		"cron":       cronClient,
+		"dns":        dnsClient,
+		"domain":     domainClient,
+		"email":      emailClient,
+		"ftp":        ftpClient,
+		"mysql":      mySQLClient,
 		"postgresql": postgreSQLClient,
+		"ssh":        sshClient,
+		"ssl":        sslClient,
 	}
 
 	tflog.Error(ctx, "Configured module clients", map[string]any{"success": true})
@@ -199,24 +399,130 @@ func (p *cpanelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 // DataSources defines the data sources implemented in the provider.
 func (p *cpanelProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAutoSSLStatusDataSource,
 		NewCronJobDataSource,
+		NewDomainsDataSource,
+		NewMySQLDatabaseDataSource,
+		NewMySQLUserDataSource,
 		NewPostgreSQLDatabaseDataSource,
 		NewPostgreSQLUserDataSource,
+		NewSSHKeysDataSource,
 	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *cpanelProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAddonDomainResource,
+		NewAutoSSLExcludedDomainsResource,
 		NewCronJobResource,
+		NewCronSettingsResource,
+		NewDNSRecordResource,
+		NewDNSZoneResource,
+		NewDomainAliasResource,
+		NewEmailAccountResource,
+		NewEmailDomainForwarderResource,
+		NewEmailForwarderResource,
+		NewFTPAccountResource,
+		NewMySQLDatabaseResource,
+		NewMySQLDatabasePrivilegesResource,
+		NewMySQLUserResource,
 		NewPostgreSQLDatabaseResource,
+		NewPostgreSQLDatabaseGrantResource,
 		NewPostgreSQLUserResource,
+		NewSSHKeyResource,
+		NewSSLCertificateResource,
+		NewSSLCSRResource,
+		NewSSLKeyResource,
+		NewSubdomainResource,
 	}
 }
 
 // cpanelProviderModel maps provider schema data to a Go type.
 type cpanelProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	ApiToken types.String `tfsdk:"api_token"`
-	Host     types.String `tfsdk:"host"`
+	Username           types.String `tfsdk:"username"`
+	ApiToken           types.String `tfsdk:"api_token"`
+	Host               types.String `tfsdk:"host"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait       types.String `tfsdk:"retry_max_wait"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// tlsOptionsFromConfig builds the TLS options of the client from the provider
+// configuration, defaulting to the CPANEL_* environment variables.
+func tlsOptionsFromConfig(config cpanelProviderModel, diags *diag.Diagnostics) cpanel.TLSOptions {
+	var options cpanel.TLSOptions
+
+	caCertFile := os.Getenv("CPANEL_CA_CERT_FILE")
+	caCertPEM := os.Getenv("CPANEL_CA_CERT_PEM")
+	clientCertFile := os.Getenv("CPANEL_CLIENT_CERT_FILE")
+	clientKeyFile := os.Getenv("CPANEL_CLIENT_KEY_FILE")
+
+	if !config.CACertFile.IsNull() || !config.CACertPEM.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+		caCertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.ClientCertFile.IsNull() {
+		clientCertFile = config.ClientCertFile.ValueString()
+	}
+
+	if !config.ClientKeyFile.IsNull() {
+		clientKeyFile = config.ClientKeyFile.ValueString()
+	}
+
+	if value := os.Getenv("CPANEL_INSECURE_SKIP_VERIFY"); value != "" {
+		insecureSkipVerify, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid cpanel API Insecure Skip Verify",
+				"The CPANEL_INSECURE_SKIP_VERIFY environment variable must be a boolean, got: "+value,
+			)
+		}
+		options.InsecureSkipVerify = insecureSkipVerify
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		options.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	}
+
+	options.CACertPEM = []byte(caCertPEM)
+	if caCertFile != "" {
+		options.CACertPEM = readPEMFile(caCertFile, "ca_cert_file", diags)
+	}
+
+	if clientCertFile != "" || clientKeyFile != "" {
+		if clientCertFile == "" || clientKeyFile == "" {
+			diags.AddAttributeError(
+				path.Root("client_cert_file"),
+				"Incomplete cpanel API Client Certificate",
+				"Both client_cert_file and client_key_file, or the CPANEL_CLIENT_CERT_FILE and CPANEL_CLIENT_KEY_FILE environment variables, must be set.",
+			)
+			return options
+		}
+
+		options.ClientCertPEM = readPEMFile(clientCertFile, "client_cert_file", diags)
+		options.ClientKeyPEM = readPEMFile(clientKeyFile, "client_key_file", diags)
+	}
+
+	return options
+}
+
+func readPEMFile(name, attribute string, diags *diag.Diagnostics) []byte {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Unable to Read cpanel API Certificate",
+			fmt.Sprintf("The provider cannot read %s: %s", name, err),
+		)
+	}
+
+	return content
 }
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestProviderValidation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "cpanel" {
+					  retry_max_wait = "5 seconds"
+					}
+
+					data "cpanel_postgresql_database" "database" {
+					  name = "database"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Attribute retry_max_wait must be a positive\s+duration`),
+			},
+			{
+				Config: `
+					provider "cpanel" {
+					  request_timeout = "0s"
+					}
+
+					data "cpanel_domains" "domains" {}
+				`,
+				ExpectError: regexp.MustCompile(`Attribute request_timeout must be a positive\s+duration`),
+			},
+		},
+	})
+}
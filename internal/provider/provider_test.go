@@ -4,8 +4,18 @@
 package provider
 
 import (
+	"context"
+	"os"
+	"os/exec"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
 )
 
 const (
@@ -30,3 +40,58 @@ const (
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"cpanel": providerserver.NewProtocol6WithError(New("test")()),
 }
+
+// newTestClient returns a cPanel client sending its requests to server.
+func newTestClient(t *testing.T, server *cpaneltest.Server) *cpanel.Client {
+	t.Helper()
+
+	host, username, apiToken := server.URL, cpaneltest.Username, cpaneltest.APIToken
+	client, err := cpanel.NewClient(&host, &username, &apiToken, cpanel.WithRetry(0, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+// testUnitPreCheck skips the unit tests driving the Terraform CLI when it is
+// not installed, since they run against the fake cPanel server but still need
+// Terraform to plan and apply.
+func testUnitPreCheck(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+
+	if _, err := exec.LookPath("terraform"); err != nil {
+		t.Skip("Terraform CLI not found, set TF_ACC_TERRAFORM_PATH to run this test")
+	}
+}
+
+// readResource calls Read on r with priorState, and returns the refreshed state.
+func readResource(t *testing.T, r resource.Resource, priorState interface{}) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, priorState); diags.HasError() {
+		t.Fatalf("unable to set prior state: %v", diags)
+	}
+
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Schema: state.Schema, Raw: state.Raw.Copy()},
+	}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected Read diagnostics: %v", resp.Diagnostics)
+	}
+
+	return resp.State
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/ssh"
+)
+
+type SSHKeyModel struct {
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Authorized  types.Bool   `tfsdk:"authorized"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+func SSHKeyAPIToModel(key *ssh.KeyDataSourceDataModel) (*SSHKeyModel, error) {
+	fingerprint, err := ssh.Fingerprint(key.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SSHKeyModel{
+		Name:        types.StringValue(key.Name),
+		PublicKey:   types.StringValue(key.Key),
+		Authorized:  types.BoolValue(key.Authorized()),
+		Fingerprint: types.StringValue(fingerprint),
+	}, nil
+}
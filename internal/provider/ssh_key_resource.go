@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/ssh"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sshKeyResource{}
+	_ resource.ResourceWithConfigure   = &sshKeyResource{}
+	_ resource.ResourceWithImportState = &sshKeyResource{}
+)
+
+// NewSSHKeyResource is a helper function to simplify the provider implementation.
+func NewSSHKeyResource() resource.Resource {
+	return &sshKeyResource{}
+}
+
+// sshKeyResource is the resource implementation.
+type sshKeyResource struct {
+	client *ssh.Client
+}
+
+// Metadata returns the resource type name.
+func (r *sshKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *sshKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a public SSH key of the account, and whether it is authorized to log in.",
+		MarkdownDescription: "Manages a public SSH key of the account, and whether it is authorized to log in.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the key, used as its file name in the .ssh directory.",
+				MarkdownDescription: "The name of the key, used as its file name in the `.ssh` directory.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9._-]+$`), "must only contain letters, digits, dots, underscores and hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Required:            true,
+				Description:         "The public key in the authorized_keys format, such as ssh-ed25519 AAAA... deploy@example.com. Differences in whitespace with the key stored by cPanel are ignored.",
+				MarkdownDescription: "The public key in the `authorized_keys` format, such as `ssh-ed25519 AAAA... deploy@example.com`. Differences in whitespace with the key stored by cPanel are ignored.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`(?s)^\s*(ssh|ecdsa|sk)-\S+ [A-Za-z0-9+/]+=*(\s.*)?$`), "must be a public key in the authorized_keys format"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"authorized": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				Description:         "Whether the key is listed in authorized_keys, allowing it to log in. Defaults to true.",
+				MarkdownDescription: "Whether the key is listed in `authorized_keys`, allowing it to log in. Defaults to `true`.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The SHA256 fingerprint of the key, as printed by ssh-keygen -l.",
+				MarkdownDescription: "The SHA256 fingerprint of the key, as printed by `ssh-keygen -l`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sshKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state SSHKeyModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read SSH key
+	key, err := r.client.GetKey(state.Name.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSH key",
+			"Could not get SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the key from the state if it has been deleted outside Terraform
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model, err := SSHKeyAPIToModel(key)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSH key",
+			"Could not read SSH key fingerprint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Keep the configured key when only its formatting or comment differ
+	if !ssh.SamePublicKey(state.PublicKey.ValueString(), key.Key) {
+		state.PublicKey = model.PublicKey
+	}
+	state.Authorized = model.Authorized
+	state.Fingerprint = model.Fingerprint
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SSHKeyModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fingerprint, err := ssh.Fingerprint(plan.PublicKey.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_key"),
+			"Invalid Public Key",
+			"Could not compute the fingerprint of the public key: "+err.Error(),
+		)
+		return
+	}
+
+	// Generate API request parameters from plan
+	var key ssh.KeyImportModel
+	key.Name = plan.Name.ValueString()
+	key.Key = strings.TrimSpace(plan.PublicKey.ValueString())
+
+	// Import new SSH key
+	_, err = r.client.ImportKey(key)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SSH key",
+			"Could not create SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Imported keys are not authorized until they are added to authorized_keys
+	if plan.Authorized.ValueBool() {
+		err = r.authorize(key.Name, true)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating SSH key",
+				"Could not authorize SSH key, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.Fingerprint = types.StringValue(fingerprint)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *sshKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan SSHKeyModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SSHKeyModel
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Authorize or deauthorize the key
+	if !plan.Authorized.Equal(state.Authorized) {
+		err := r.authorize(plan.Name.ValueString(), plan.Authorized.ValueBool())
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating SSH key",
+				"Could not change SSH key authorization, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sshKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state SSHKeyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the key from authorized_keys first, as deleting its file does not
+	if state.Authorized.ValueBool() {
+		err := r.authorize(state.Name.ValueString(), false)
+
+		if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error deleting SSH key",
+				"Could not deauthorize SSH key, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var key ssh.KeyDeleteModel
+	key.Name = state.Name.ValueString()
+
+	// Delete existing SSH key
+	_, err := r.client.DeleteKey(key)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting SSH key",
+			"Could not delete SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the SSH key from its name.
+func (r *sshKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	key, err := r.client.GetKey(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSH key",
+			"Could not get SSH key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if key == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the name of an existing public SSH key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	model, err := SSHKeyAPIToModel(key)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSH key",
+			"Could not read SSH key fingerprint, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sshKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sshClient, ok := providerData["ssh"].(*ssh.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected SSH Client Type",
+			fmt.Sprintf("Expected *ssh.Client, got: %T. Please report this issue to the provider developers.", providerData["ssh"]),
+		)
+		return
+	}
+
+	r.client = sshClient
+}
+
+// authorize adds the key to authorized_keys, or removes it from it.
+func (r *sshKeyResource) authorize(name string, authorized bool) error {
+	var key ssh.KeyAuthorizeModel
+	key.Name = name
+	key.Authorize = authorized
+
+	_, err := r.client.AuthorizeKey(key)
+
+	return err
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"terraform-provider-cpanel/internal/cpanel/ssh"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &sshKeysDataSource{}
+	_ datasource.DataSourceWithConfigure = &sshKeysDataSource{}
+)
+
+// NewSSHKeysDataSource is a helper function to simplify the provider implementation.
+func NewSSHKeysDataSource() datasource.DataSource {
+	return &sshKeysDataSource{}
+}
+
+// sshKeysDataSource is the data source implementation.
+type sshKeysDataSource struct {
+	client *ssh.Client
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *sshKeysDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sshClient, ok := providerData["ssh"].(*ssh.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected SSH Client Type",
+			fmt.Sprintf("Expected *ssh.Client, got: %T. Please report this issue to the provider developers.", providerData["ssh"]),
+		)
+		return
+	}
+
+	d.client = sshClient
+}
+
+// Metadata returns the data source type name.
+func (d *sshKeysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_keys"
+}
+
+// Schema defines the schema for the data source.
+func (d *sshKeysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Lists the public SSH keys of the account.",
+		MarkdownDescription: "Lists the public SSH keys of the account.",
+		Attributes: map[string]schema.Attribute{
+			"keys": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The public keys, sorted by name.",
+				MarkdownDescription: "The public keys, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the key.",
+							MarkdownDescription: "The name of the key.",
+						},
+						"public_key": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The public key in the authorized_keys format.",
+							MarkdownDescription: "The public key in the `authorized_keys` format.",
+						},
+						"fingerprint": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The SHA256 fingerprint of the key, as printed by ssh-keygen -l.",
+							MarkdownDescription: "The SHA256 fingerprint of the key, as printed by `ssh-keygen -l`.",
+						},
+						"authorized": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the key is listed in authorized_keys, allowing it to log in.",
+							MarkdownDescription: "Whether the key is listed in `authorized_keys`, allowing it to log in.",
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *sshKeysDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	keys, err := d.client.GetKeys()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read SSH keys: %s", err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The list does not hold the contents of the keys
+	for i, key := range keys.CpanelResult.Data {
+		keys.CpanelResult.Data[i].Key, err = d.client.FetchKey(key.Name)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Unable to Read SSH key %s: %s", key.Name, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	state, err := SSHKeysAPIToModel(keys.CpanelResult.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to Read SSH keys: %s", err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
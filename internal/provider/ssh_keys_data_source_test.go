@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+)
+
+func TestAccSSHKeysDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: providerConfig + `data "cpanel_ssh_keys" "keys" {}`,
+				Check:  resource.TestCheckResourceAttrSet("data.cpanel_ssh_keys.keys", "keys.#"),
+			},
+		},
+	})
+}
+
+func TestSSHKeysDataSource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddSSHKey("deploy", testSSHPublicKey, true)
+	server.AddSSHKey("ci", testSSHOtherPublicKey, false)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: server.ProviderConfig() + `data "cpanel_ssh_keys" "keys" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.0.name", "ci"),
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.0.public_key", testSSHOtherPublicKey),
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.0.fingerprint", "SHA256:Gg8uBaDX+pstmwTPN/nn5TpiLALikMb91I6hFSBBiPI"),
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.0.authorized", "false"),
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.1.name", "deploy"),
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.1.fingerprint", testSSHPublicKeyFingerprint),
+					resource.TestCheckResourceAttr("data.cpanel_ssh_keys.keys", "keys.1.authorized", "true"),
+					resource.TestCheckResourceAttrSet("data.cpanel_ssh_keys.keys", "last_updated"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/ssh"
+	"time"
+)
+
+type SSHKeysDataSourceModel struct {
+	Keys        []SSHKeysDataSourceKeyModel `tfsdk:"keys"`
+	LastUpdated types.String                `tfsdk:"last_updated"`
+}
+
+type SSHKeysDataSourceKeyModel struct {
+	Name        types.String `tfsdk:"name"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	Authorized  types.Bool   `tfsdk:"authorized"`
+}
+
+func SSHKeysAPIToModel(keys []ssh.KeyDataSourceDataModel) (*SSHKeysDataSourceModel, error) {
+	keyModels := make([]SSHKeysDataSourceKeyModel, 0, len(keys))
+	for _, key := range keys {
+		model, err := SSHKeyAPIToModel(&key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %w", key.Name, err)
+		}
+
+		keyModels = append(keyModels, SSHKeysDataSourceKeyModel{
+			Name:        model.Name,
+			PublicKey:   model.PublicKey,
+			Fingerprint: model.Fingerprint,
+			Authorized:  model.Authorized,
+		})
+	}
+
+	return &SSHKeysDataSourceModel{
+		Keys:        keyModels,
+		LastUpdated: types.StringValue(time.Now().Format(time.RFC3339)),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+	"time"
+)
+
+type SSLCertificateModel struct {
+	Domain                  types.String `tfsdk:"domain"`
+	Certificate             types.String `tfsdk:"certificate"`
+	PrivateKey              types.String `tfsdk:"private_key"`
+	CABundle                types.String `tfsdk:"ca_bundle"`
+	CertificateID           types.String `tfsdk:"certificate_id"`
+	Issuer                  types.String `tfsdk:"issuer"`
+	NotAfter                types.String `tfsdk:"not_after"`
+	SubjectAlternativeNames types.List   `tfsdk:"subject_alternative_names"`
+	LastUpdated             types.String `tfsdk:"last_updated"`
+}
+
+// SSLCertificateHostToModel sets the attributes read from the installed certificate.
+func SSLCertificateHostToModel(ctx context.Context, host *ssl.InstalledHostDataSourceDataModel, model *SSLCertificateModel) diag.Diagnostics {
+	issuer := host.Certificate.IssuerOrganizationName
+	if issuer == "" {
+		issuer = host.Certificate.IssuerCommonName
+	}
+
+	subjectAlternativeNames, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, host.Certificate.Domains...))
+
+	model.CertificateID = types.StringValue(host.Certificate.ID)
+	model.Issuer = types.StringValue(issuer)
+	model.NotAfter = types.StringValue(time.Unix(host.Certificate.NotAfter, 0).UTC().Format(time.RFC3339))
+	model.SubjectAlternativeNames = subjectAlternativeNames
+
+	return diags
+}
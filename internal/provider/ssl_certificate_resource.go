@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sslCertificateResource{}
+	_ resource.ResourceWithConfigure   = &sslCertificateResource{}
+	_ resource.ResourceWithImportState = &sslCertificateResource{}
+)
+
+// NewSSLCertificateResource is a helper function to simplify the provider implementation.
+func NewSSLCertificateResource() resource.Resource {
+	return &sslCertificateResource{}
+}
+
+// sslCertificateResource is the resource implementation.
+type sslCertificateResource struct {
+	client *ssl.Client
+}
+
+// Metadata returns the resource type name.
+func (r *sslCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_certificate"
+}
+
+// Schema defines the schema for the resource.
+func (r *sslCertificateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Installs an SSL certificate on a domain of the account. The certificate is installed again when it is changed outside Terraform.",
+		MarkdownDescription: "Installs an SSL certificate on a domain of the account. The certificate is installed again when it is changed outside Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain to install the certificate on.",
+				MarkdownDescription: "The domain to install the certificate on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Required:            true,
+				Description:         "The PEM encoded certificate.",
+				MarkdownDescription: "The PEM encoded certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				Description:         "The PEM encoded private key of the certificate.",
+				MarkdownDescription: "The PEM encoded private key of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ca_bundle": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The PEM encoded intermediate certificates of the issuer.",
+				MarkdownDescription: "The PEM encoded intermediate certificates of the issuer.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The identifier of the certificate in cPanel.",
+				MarkdownDescription: "The identifier of the certificate in cPanel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The organization, or the common name, of the issuer of the certificate.",
+				MarkdownDescription: "The organization, or the common name, of the issuer of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The expiry date of the certificate, in RFC 3339 format.",
+				MarkdownDescription: "The expiry date of the certificate, in RFC 3339 format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subject_alternative_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The domains the certificate is valid for.",
+				MarkdownDescription: "The domains the certificate is valid for.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sslCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state SSLCertificateModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read installed certificate
+	host, err := r.client.GetInstalledHost(state.Domain.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL certificate",
+			"Could not get SSL certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the certificate from the state if it has been uninstalled outside Terraform
+	if host == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Report another certificate installed outside Terraform, so that it is replaced
+	if !ssl.SamePEM(host.CertificateText, state.Certificate.ValueString()) {
+		state.Certificate = types.StringValue(host.CertificateText)
+	}
+
+	resp.Diagnostics.Append(SSLCertificateHostToModel(ctx, host, &state)...)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sslCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SSLCertificateModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Install certificate
+	var certificate ssl.CertificateInstallModel
+	certificate.Domain = plan.Domain.ValueString()
+	certificate.Certificate = plan.Certificate.ValueString()
+	certificate.Key = plan.PrivateKey.ValueString()
+	certificate.CABundle = plan.CABundle.ValueString()
+
+	_, err := r.client.InstallCertificate(certificate)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SSL certificate",
+			"Could not create SSL certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Read the expiry, issuer and domains parsed by cPanel
+	host, err := r.client.GetInstalledHost(certificate.Domain)
+
+	if err == nil && host == nil {
+		err = errors.New("the installed certificate was not found")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL certificate",
+			"Could not get SSL certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(SSLCertificateHostToModel(ctx, host, &plan)...)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the certificate to be replaced.
+func (r *sslCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SSLCertificateModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sslCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state SSLCertificateModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Uninstall existing certificate
+	var certificate ssl.CertificateDeleteModel
+	certificate.Domain = state.Domain.ValueString()
+
+	_, err := r.client.DeleteCertificate(certificate)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting SSL certificate",
+			"Could not delete SSL certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the certificate installed on a domain, along with its private key and CA bundle.
+func (r *sslCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	host, err := r.client.GetInstalledHost(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL certificate",
+			"Could not get SSL certificate, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if host == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a domain with an SSL certificate installed, such as example.com. Got: %q", req.ID),
+		)
+		return
+	}
+
+	keyAndCABundle, err := r.client.GetKeyAndCABundle(host.CertificateText)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL certificate key",
+			"Could not get SSL certificate key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state := SSLCertificateModel{
+		Domain:      types.StringValue(req.ID),
+		Certificate: types.StringValue(host.CertificateText),
+		PrivateKey:  types.StringValue(keyAndCABundle.Data.Key),
+		CABundle:    optionalStringValue(keyAndCABundle.Data.CABundle),
+	}
+	resp.Diagnostics.Append(SSLCertificateHostToModel(ctx, host, &state)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sslCertificateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sslClient, ok := providerData["ssl"].(*ssl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected SSL Client Type",
+			fmt.Sprintf("Expected *ssl.Client, got: %T. Please report this issue to the provider developers.", providerData["ssl"]),
+		)
+		return
+	}
+
+	r.client = sslClient
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+)
+
+func TestAccSSLCertificateResource(t *testing.T) {
+	certificate, key := cpaneltest.NewCertificate(t, "Terraform Acceptance Tests", time.Now().AddDate(0, 1, 0), "tf-acc-ssl.odns.fr")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + fmt.Sprintf(`
+					resource "cpanel_subdomain" "ssl" {
+						subdomain = "tf-acc-ssl"
+						domain    = "odns.fr"
+					}
+
+					resource "cpanel_ssl_certificate" "certificate" {
+						domain      = "${cpanel_subdomain.ssl.subdomain}.${cpanel_subdomain.ssl.domain}"
+						certificate = %q
+						private_key = %q
+					}
+				`, certificate, key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "issuer", "Terraform Acceptance Tests"),
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "subject_alternative_names.0", "tf-acc-ssl.odns.fr"),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_certificate.certificate", "not_after"),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_certificate.certificate", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_ssl_certificate.certificate",
+				ImportStateId:                        "tf-acc-ssl.odns.fr",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestSSLCertificateResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	notAfter := time.Now().AddDate(0, 3, 0).UTC().Truncate(time.Second)
+	certificate, key := cpaneltest.NewCertificate(t, "Example CA", notAfter, "example.com", "www.example.com")
+	renewedCertificate, renewedKey := cpaneltest.NewCertificate(t, "Other CA", notAfter.AddDate(0, 3, 0), "example.com")
+
+	config := func(certificate, key string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+			resource "cpanel_ssl_certificate" "certificate" {
+				domain      = "example.com"
+				certificate = %q
+				private_key = %q
+			}
+		`, certificate, key)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if server.SSLCertificate("example.com") != nil {
+				return fmt.Errorf("expected the certificate of example.com to be uninstalled")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(certificate, key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "issuer", "Example CA"),
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "not_after", notAfter.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "subject_alternative_names.#", "2"),
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "subject_alternative_names.0", "example.com"),
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "subject_alternative_names.1", "www.example.com"),
+					resource.TestCheckNoResourceAttr("cpanel_ssl_certificate.certificate", "ca_bundle"),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_certificate.certificate", "certificate_id"),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_certificate.certificate", "last_updated"),
+					checkSSLCertificate(server, "example.com", certificate),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_ssl_certificate.certificate",
+				ImportStateId:                        "example.com",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Another certificate installed outside Terraform is detected
+			{
+				PreConfig:          func() { server.InstallSSLCertificate("example.com", renewedCertificate, renewedKey, "") },
+				Config:             config(certificate, key),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// and replaced
+			{
+				Config: config(certificate, key),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "issuer", "Example CA"),
+					checkSSLCertificate(server, "example.com", certificate),
+				),
+			},
+			// Replace testing
+			{
+				Config: config(renewedCertificate, renewedKey),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "issuer", "Other CA"),
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "not_after", notAfter.AddDate(0, 3, 0).Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("cpanel_ssl_certificate.certificate", "subject_alternative_names.#", "1"),
+					checkSSLCertificate(server, "example.com", renewedCertificate),
+				),
+			},
+			// Re-create after uninstallation outside Terraform
+			{
+				PreConfig: func() { server.DeleteSSLCertificate("example.com") },
+				Config:    config(renewedCertificate, renewedKey),
+				Check:     checkSSLCertificate(server, "example.com", renewedCertificate),
+			},
+		},
+	})
+}
+
+func TestSSLCertificateResourceKeyMismatch(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	certificate, _ := cpaneltest.NewCertificate(t, "Example CA", time.Now().AddDate(0, 3, 0), "example.com")
+	_, otherKey := cpaneltest.NewCertificate(t, "Example CA", time.Now().AddDate(0, 3, 0), "example.com")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + fmt.Sprintf(`
+					resource "cpanel_ssl_certificate" "certificate" {
+						domain      = "example.com"
+						certificate = %q
+						private_key = %q
+					}
+				`, certificate, otherKey),
+				ExpectError: regexp.MustCompile(`does not match the key`),
+			},
+		},
+	})
+}
+
+func TestSSLCertificateResourceReadRemovesUninstalledCertificate(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	certificate, key := cpaneltest.NewCertificate(t, "Example CA", time.Now().AddDate(0, 3, 0), "example.com")
+
+	r := &sslCertificateResource{client: ssl.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &SSLCertificateModel{
+		Domain:                  types.StringValue("example.com"),
+		Certificate:             types.StringValue(certificate),
+		PrivateKey:              types.StringValue(key),
+		CABundle:                types.StringNull(),
+		CertificateID:           types.StringValue("example_com_0123456789_1700000000"),
+		Issuer:                  types.StringValue("Example CA"),
+		NotAfter:                types.StringValue("2024-01-01T00:00:00Z"),
+		SubjectAlternativeNames: types.ListNull(types.StringType),
+		LastUpdated:             types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the uninstalled certificate to be removed from the state")
+	}
+}
+
+func checkSSLCertificate(server *cpaneltest.Server, domain, certificate string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		installed := server.SSLCertificate(domain)
+		if installed == nil {
+			return fmt.Errorf("expected a certificate to be installed on %s", domain)
+		}
+		if !ssl.SamePEM(installed.Certificate, certificate) {
+			return fmt.Errorf("expected another certificate to be installed on %s", domain)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+)
+
+type SSLCSRModel struct {
+	ID                     types.String   `tfsdk:"id"`
+	KeyID                  types.String   `tfsdk:"key_id"`
+	Domains                []types.String `tfsdk:"domains"`
+	CountryName            types.String   `tfsdk:"country_name"`
+	StateOrProvinceName    types.String   `tfsdk:"state_or_province_name"`
+	LocalityName           types.String   `tfsdk:"locality_name"`
+	OrganizationName       types.String   `tfsdk:"organization_name"`
+	OrganizationalUnitName types.String   `tfsdk:"organizational_unit_name"`
+	EmailAddress           types.String   `tfsdk:"email_address"`
+	FriendlyName           types.String   `tfsdk:"friendly_name"`
+	PEM                    types.String   `tfsdk:"pem"`
+	LastUpdated            types.String   `tfsdk:"last_updated"`
+}
+
+func SSLCSRModelToAPI(model *SSLCSRModel) ssl.CSRCreateModel {
+	domains := make([]string, 0, len(model.Domains))
+	for _, domain := range model.Domains {
+		domains = append(domains, domain.ValueString())
+	}
+
+	return ssl.CSRCreateModel{
+		KeyID:                  model.KeyID.ValueString(),
+		Domains:                domains,
+		CountryName:            model.CountryName.ValueString(),
+		StateOrProvinceName:    model.StateOrProvinceName.ValueString(),
+		LocalityName:           model.LocalityName.ValueString(),
+		OrganizationName:       model.OrganizationName.ValueString(),
+		OrganizationalUnitName: model.OrganizationalUnitName.ValueString(),
+		EmailAddress:           model.EmailAddress.ValueString(),
+		FriendlyName:           model.FriendlyName.ValueString(),
+	}
+}
+
+func SSLCSRAPIToModel(csr *ssl.CSRDataSourceDataModel, keyID string, text string) *SSLCSRModel {
+	domains := make([]types.String, 0, len(csr.Domains))
+	for _, domain := range csr.Domains {
+		domains = append(domains, types.StringValue(domain))
+	}
+
+	return &SSLCSRModel{
+		ID:                     types.StringValue(csr.ID),
+		KeyID:                  types.StringValue(keyID),
+		Domains:                domains,
+		CountryName:            types.StringValue(csr.CountryName),
+		StateOrProvinceName:    types.StringValue(csr.StateOrProvinceName),
+		LocalityName:           types.StringValue(csr.LocalityName),
+		OrganizationName:       types.StringValue(csr.OrganizationName),
+		OrganizationalUnitName: optionalStringValue(csr.OrganizationalUnitName),
+		EmailAddress:           optionalStringValue(csr.EmailAddress),
+		FriendlyName:           types.StringValue(csr.FriendlyName),
+		PEM:                    types.StringValue(text),
+	}
+}
+
+// optionalStringValue returns a null string for the empty values of optional attributes.
+func optionalStringValue(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
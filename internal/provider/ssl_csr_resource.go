@@ -0,0 +1,314 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sslCSRResource{}
+	_ resource.ResourceWithConfigure   = &sslCSRResource{}
+	_ resource.ResourceWithImportState = &sslCSRResource{}
+)
+
+// NewSSLCSRResource is a helper function to simplify the provider implementation.
+func NewSSLCSRResource() resource.Resource {
+	return &sslCSRResource{}
+}
+
+// sslCSRResource is the resource implementation.
+type sslCSRResource struct {
+	client *ssl.Client
+}
+
+// Metadata returns the resource type name.
+func (r *sslCSRResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_csr"
+}
+
+// Schema defines the schema for the resource.
+func (r *sslCSRResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Every subject attribute requires a new request to be generated
+	requiredSubjectAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Required:            true,
+			Description:         description,
+			MarkdownDescription: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	optionalSubjectAttribute := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			Description:         description,
+			MarkdownDescription: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	countryName := requiredSubjectAttribute("The two-letter ISO 3166 code of the country of the organization.")
+	countryName.Validators = []validator.String{
+		stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z]{2}$`), "must be a two-letter uppercase country code"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         "Generates a certificate signing request stored in the SSL/TLS manager of the account.",
+		MarkdownDescription: "Generates a certificate signing request stored in the SSL/TLS manager of the account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The identifier of the certificate signing request.",
+				MarkdownDescription: "The identifier of the certificate signing request.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_id": requiredSubjectAttribute("The identifier of the private key to sign the request with."),
+			"domains": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The domains to request a certificate for, the first one being the common name.",
+				MarkdownDescription: "The domains to request a certificate for, the first one being the common name.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"country_name":             countryName,
+			"state_or_province_name":   requiredSubjectAttribute("The state or province of the organization."),
+			"locality_name":            requiredSubjectAttribute("The city of the organization."),
+			"organization_name":        requiredSubjectAttribute("The name of the organization."),
+			"organizational_unit_name": optionalSubjectAttribute("The department of the organization."),
+			"email_address":            optionalSubjectAttribute("The contact email address."),
+			"friendly_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the certificate signing request in cPanel. Defaults to a name chosen by cPanel.",
+				MarkdownDescription: "The name of the certificate signing request in cPanel. Defaults to a name chosen by cPanel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pem": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The PEM encoded certificate signing request, to submit to a certificate authority.",
+				MarkdownDescription: "The PEM encoded certificate signing request, to submit to a certificate authority.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sslCSRResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state SSLCSRModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read certificate signing request
+	csr, err := r.client.GetCSR(state.ID.ValueString())
+
+	// Remove the request from the state if it has been deleted outside Terraform
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL certificate signing request",
+			"Could not get SSL certificate signing request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.FriendlyName = types.StringValue(csr.Data.Details.FriendlyName)
+	state.PEM = types.StringValue(csr.Data.Text)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sslCSRResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SSLCSRModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate new certificate signing request
+	csr, err := r.client.CreateCSR(SSLCSRModelToAPI(&plan))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SSL certificate signing request",
+			"Could not create SSL certificate signing request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(csr.Data.ID)
+	plan.FriendlyName = types.StringValue(csr.Data.FriendlyName)
+	plan.PEM = types.StringValue(csr.Data.Text)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the certificate signing request to be replaced.
+func (r *sslCSRResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SSLCSRModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sslCSRResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state SSLCSRModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing certificate signing request
+	var csr ssl.CSRDeleteModel
+	csr.ID = state.ID.ValueString()
+
+	_, err := r.client.DeleteCSR(csr)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting SSL certificate signing request",
+			"Could not delete SSL certificate signing request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a certificate signing request from its identifier, finding the key it was
+// generated with among the keys of the account.
+func (r *sslCSRResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	csr, err := r.client.GetCSR(req.ID)
+
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the identifier of an existing certificate signing request. Got: %q", req.ID),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL certificate signing request",
+			"Could not get SSL certificate signing request, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	keys, err := r.client.GetKeys()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL keys",
+			"Could not get SSL keys, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	for _, key := range keys.Data {
+		if key.PublicKey() != "" && key.PublicKey() == csr.Data.Details.PublicKey() {
+			resp.Diagnostics.Append(resp.State.Set(ctx, SSLCSRAPIToModel(&csr.Data.Details, key.ID, csr.Data.Text))...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Error importing SSL certificate signing request",
+		fmt.Sprintf("The key of the certificate signing request %q was not found.", req.ID),
+	)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sslCSRResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sslClient, ok := providerData["ssl"].(*ssl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected SSL Client Type",
+			fmt.Sprintf("Expected *ssl.Client, got: %T. Please report this issue to the provider developers.", providerData["ssl"]),
+		)
+		return
+	}
+
+	r.client = sslClient
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+)
+
+func TestAccSSLCSRResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_ssl_key" "key" {
+						key_type = "ecdsa-prime256v1"
+					}
+
+					resource "cpanel_ssl_csr" "csr" {
+						key_id                 = cpanel_ssl_key.key.id
+						domains                = ["odns.fr", "www.odns.fr"]
+						country_name           = "FR"
+						state_or_province_name = "Ile-de-France"
+						locality_name          = "Paris"
+						organization_name      = "Terraform Acceptance Tests"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cpanel_ssl_csr.csr", "key_id", "cpanel_ssl_key.key", "id"),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_csr.csr", "id"),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_csr.csr", "pem"),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_csr.csr", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cpanel_ssl_csr.csr",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+func TestSSLCSRResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	config := func(domains string) string {
+		return server.ProviderConfig() + fmt.Sprintf(`
+			resource "cpanel_ssl_key" "key" {
+				key_type = "ecdsa-prime256v1"
+			}
+
+			resource "cpanel_ssl_csr" "csr" {
+				key_id                 = cpanel_ssl_key.key.id
+				domains                = %s
+				country_name           = "FR"
+				state_or_province_name = "Ile-de-France"
+				locality_name          = "Paris"
+				organization_name      = "Example"
+				email_address          = "admin@example.com"
+				friendly_name          = "example-csr"
+			}
+		`, domains)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(`["example.com", "www.example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cpanel_ssl_csr.csr", "key_id", "cpanel_ssl_key.key", "id"),
+					resource.TestCheckResourceAttr("cpanel_ssl_csr.csr", "friendly_name", "example-csr"),
+					resource.TestCheckNoResourceAttr("cpanel_ssl_csr.csr", "organizational_unit_name"),
+					resource.TestMatchResourceAttr("cpanel_ssl_csr.csr", "pem", regexp.MustCompile(`^-----BEGIN CERTIFICATE REQUEST-----`)),
+					resource.TestCheckResourceAttrSet("cpanel_ssl_csr.csr", "last_updated"),
+					checkSSLCSR(server, "cpanel_ssl_csr.csr"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cpanel_ssl_csr.csr",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Replace testing
+			{
+				Config: config(`["www.example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_ssl_csr.csr", "domains.#", "1"),
+					checkSSLCSR(server, "cpanel_ssl_csr.csr"),
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() {
+					for _, id := range server.SSLCSRIDs() {
+						server.DeleteSSLCSR(id)
+					}
+				},
+				Config: config(`["www.example.com"]`),
+				Check:  checkSSLCSR(server, "cpanel_ssl_csr.csr"),
+			},
+		},
+	})
+}
+
+func TestSSLCSRResourceValidation(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_ssl_csr" "csr" {
+						key_id                 = "0123456789_1"
+						domains                = ["example.com"]
+						country_name           = "France"
+						state_or_province_name = "Ile-de-France"
+						locality_name          = "Paris"
+						organization_name      = "Example"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`must be a two-letter uppercase country code`),
+			},
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_ssl_csr" "csr" {
+						key_id                 = "0123456789_1"
+						domains                = []
+						country_name           = "FR"
+						state_or_province_name = "Ile-de-France"
+						locality_name          = "Paris"
+						organization_name      = "Example"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`list must contain at least 1 elements`),
+			},
+		},
+	})
+}
+
+func TestSSLCSRResourceReadRemovesDeletedCSR(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	r := &sslCSRResource{client: ssl.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &SSLCSRModel{
+		ID:                     types.StringValue("example_com_2"),
+		KeyID:                  types.StringValue("0123456789_1"),
+		Domains:                []types.String{types.StringValue("example.com")},
+		CountryName:            types.StringValue("FR"),
+		StateOrProvinceName:    types.StringValue("Ile-de-France"),
+		LocalityName:           types.StringValue("Paris"),
+		OrganizationName:       types.StringValue("Example"),
+		OrganizationalUnitName: types.StringNull(),
+		EmailAddress:           types.StringNull(),
+		FriendlyName:           types.StringValue("example-csr"),
+		PEM:                    types.StringValue("-----BEGIN CERTIFICATE REQUEST-----"),
+		LastUpdated:            types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted certificate signing request to be removed from the state")
+	}
+}
+
+// checkSSLCSR checks that the request of the named resource exists, and that it is the only request left.
+func checkSSLCSR(server *cpaneltest.Server, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id := s.RootModule().Resources[name].Primary.Attributes["id"]
+		if !server.SSLCSRExists(id) {
+			return fmt.Errorf("expected the certificate signing request %s to exist", id)
+		}
+		if ids := server.SSLCSRIDs(); len(ids) != 1 {
+			return fmt.Errorf("expected a single certificate signing request, got %v", ids)
+		}
+		return nil
+	}
+}
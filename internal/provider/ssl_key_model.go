@@ -0,0 +1,23 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+)
+
+type SSLKeyModel struct {
+	ID           types.String `tfsdk:"id"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	KeyType      types.String `tfsdk:"key_type"`
+	PEM          types.String `tfsdk:"pem"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+func SSLKeyAPIToModel(key *ssl.KeyDataSourceDataModel, text string) *SSLKeyModel {
+	return &SSLKeyModel{
+		ID:           types.StringValue(key.ID),
+		FriendlyName: types.StringValue(key.FriendlyName),
+		KeyType:      types.StringValue(key.KeyType()),
+		PEM:          types.StringValue(text),
+	}
+}
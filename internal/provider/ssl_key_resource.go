@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/ssl"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sslKeyResource{}
+	_ resource.ResourceWithConfigure   = &sslKeyResource{}
+	_ resource.ResourceWithImportState = &sslKeyResource{}
+)
+
+// NewSSLKeyResource is a helper function to simplify the provider implementation.
+func NewSSLKeyResource() resource.Resource {
+	return &sslKeyResource{}
+}
+
+// sslKeyResource is the resource implementation.
+type sslKeyResource struct {
+	client *ssl.Client
+}
+
+// Metadata returns the resource type name.
+func (r *sslKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *sslKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Generates a private key stored in the SSL/TLS manager of the account.",
+		MarkdownDescription: "Generates a private key stored in the SSL/TLS manager of the account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The identifier of the key.",
+				MarkdownDescription: "The identifier of the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"friendly_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the key in cPanel. Defaults to a name chosen by cPanel.",
+				MarkdownDescription: "The name of the key in cPanel. Defaults to a name chosen by cPanel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("rsa-2048"),
+				Description:         "The type of the key, one of rsa-2048, rsa-4096, ecdsa-prime256v1 or ecdsa-secp384r1. Defaults to rsa-2048.",
+				MarkdownDescription: "The type of the key, one of `rsa-2048`, `rsa-4096`, `ecdsa-prime256v1` or `ecdsa-secp384r1`. Defaults to `rsa-2048`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(ssl.KeyTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pem": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				Description:         "The PEM encoded private key.",
+				MarkdownDescription: "The PEM encoded private key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *sslKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state SSLKeyModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read key
+	key, err := r.client.GetKey(state.ID.ValueString())
+
+	// Remove the key from the state if it has been deleted outside Terraform
+	if errors.Is(err, cpanel.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting SSL key",
+			"Could not get SSL key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	lastUpdated := state.LastUpdated
+	state = *SSLKeyAPIToModel(&key.Data.Key, key.Data.Text)
+	state.LastUpdated = lastUpdated
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *sslKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SSLKeyModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate new key
+	var key ssl.KeyCreateModel
+	key.FriendlyName = plan.FriendlyName.ValueString()
+	key.KeyType = plan.KeyType.ValueString()
+
+	createdKey, err := r.client.CreateKey(key)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating SSL key",
+			"Could not create SSL key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(createdKey.Data.ID)
+	plan.FriendlyName = types.StringValue(createdKey.Data.FriendlyName)
+	plan.PEM = types.StringValue(createdKey.Data.Text)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called, as every attribute requires the key to be replaced.
+func (r *sslKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SSLKeyModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sslKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state SSLKeyModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing key
+	var key ssl.KeyDeleteModel
+	key.ID = state.ID.ValueString()
+
+	_, err := r.client.DeleteKey(key)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting SSL key",
+			"Could not delete SSL key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *sslKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sslKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	sslClient, ok := providerData["ssl"].(*ssl.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected SSL Client Type",
+			fmt.Sprintf("Expected *ssl.Client, got: %T. Please report this issue to the provider developers.", providerData["ssl"]),
+		)
+		return
+	}
+
+	r.client = sslClient
+}
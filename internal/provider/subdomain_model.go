@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+)
+
+type SubdomainModel struct {
+	Subdomain    types.String `tfsdk:"subdomain"`
+	Domain       types.String `tfsdk:"domain"`
+	DocumentRoot types.String `tfsdk:"document_root"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+}
+
+func SubdomainAPIToModel(subdomain *domain.SubdomainDataSourceDataModel) *SubdomainModel {
+	return &SubdomainModel{
+		Subdomain:    types.StringValue(subdomain.Subdomain),
+		Domain:       types.StringValue(subdomain.RootDomain),
+		DocumentRoot: types.StringValue(subdomain.BaseDir),
+	}
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-cpanel/internal/cpanel"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &subdomainResource{}
+	_ resource.ResourceWithConfigure   = &subdomainResource{}
+	_ resource.ResourceWithImportState = &subdomainResource{}
+)
+
+// NewSubdomainResource is a helper function to simplify the provider implementation.
+func NewSubdomainResource() resource.Resource {
+	return &subdomainResource{}
+}
+
+// subdomainResource is the resource implementation.
+type subdomainResource struct {
+	client *domain.Client
+}
+
+// Metadata returns the resource type name.
+func (r *subdomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subdomain"
+}
+
+// Schema defines the schema for the resource.
+func (r *subdomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a subdomain of a domain of the account.",
+		MarkdownDescription: "Manages a subdomain of a domain of the account.",
+		Attributes: map[string]schema.Attribute{
+			"subdomain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The subdomain, without its domain.",
+				MarkdownDescription: "The subdomain, without its domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:            true,
+				Description:         "The domain of the subdomain.",
+				MarkdownDescription: "The domain of the subdomain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"document_root": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The document root, relative to the home directory. Defaults to a directory named after the subdomain in public_html.",
+				MarkdownDescription: "The document root, relative to the home directory. Defaults to a directory named after the subdomain in `public_html`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *subdomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state SubdomainModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read subdomain
+	subdomain, err := r.client.GetSubdomain(state.Subdomain.ValueString(), state.Domain.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting subdomain",
+			"Could not get subdomain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Remove the subdomain from the state if it has been deleted outside Terraform
+	if subdomain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.DocumentRoot = types.StringValue(subdomain.BaseDir)
+	if state.LastUpdated.IsNull() {
+		state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *subdomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SubdomainModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new subdomain
+	var subdomain domain.SubdomainCreateModel
+	subdomain.Subdomain = plan.Subdomain.ValueString()
+	subdomain.RootDomain = plan.Domain.ValueString()
+	subdomain.Dir = plan.DocumentRoot.ValueString()
+
+	_, err := r.client.CreateSubdomain(subdomain)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating subdomain",
+			"Could not create subdomain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Read the document root chosen by cPanel
+	createdSubdomain, err := r.client.GetSubdomain(subdomain.Subdomain, subdomain.RootDomain)
+
+	if err == nil && createdSubdomain == nil {
+		err = errors.New("the created subdomain was not found")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting subdomain",
+			"Could not get subdomain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.DocumentRoot = types.StringValue(createdSubdomain.BaseDir)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *subdomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan SubdomainModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Change the document root, the only attribute not requiring a replacement
+	var documentRoot domain.DocumentRootUpdateModel
+	documentRoot.Subdomain = plan.Subdomain.ValueString()
+	documentRoot.RootDomain = plan.Domain.ValueString()
+	documentRoot.Dir = plan.DocumentRoot.ValueString()
+
+	_, err := r.client.UpdateDocumentRoot(documentRoot)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating subdomain",
+			"Could not update subdomain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *subdomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state SubdomainModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing subdomain
+	var subdomain domain.SubdomainDeleteModel
+	subdomain.Domain = state.Subdomain.ValueString() + "." + state.Domain.ValueString()
+
+	_, err := r.client.DeleteSubdomain(subdomain)
+
+	if err != nil && !errors.Is(err, cpanel.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting subdomain",
+			"Could not delete subdomain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a subdomain from its fully qualified name.
+func (r *subdomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	subdomains, err := r.client.GetSubdomains()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error getting subdomains",
+			"Could not get subdomains, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	for i, subdomain := range subdomains.CpanelResult.Data {
+		if strings.EqualFold(subdomain.Domain, req.ID) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, SubdomainAPIToModel(&subdomains.CpanelResult.Data[i]))...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Unexpected Import Identifier",
+		fmt.Sprintf("Expected the fully qualified name of an existing subdomain, such as blog.example.com. Got: %q", req.ID),
+	)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *subdomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected map[string]interface{}, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	domainClient, ok := providerData["domain"].(*domain.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Domain Client Type",
+			fmt.Sprintf("Expected *domain.Client, got: %T. Please report this issue to the provider developers.", providerData["domain"]),
+		)
+		return
+	}
+
+	r.client = domainClient
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-cpanel/internal/cpanel/cpaneltest"
+	"terraform-provider-cpanel/internal/cpanel/domain"
+)
+
+func TestAccSubdomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_subdomain" "subdomain" {
+						subdomain = "tf-acc-subdomain"
+						domain = "bolo8774.odns.fr"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_subdomain.subdomain", "subdomain", "tf-acc-subdomain"),
+					resource.TestCheckResourceAttrSet("cpanel_subdomain.subdomain", "document_root"),
+					resource.TestCheckResourceAttrSet("cpanel_subdomain.subdomain", "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_subdomain.subdomain",
+				ImportStateId:                        "tf-acc-subdomain.bolo8774.odns.fr",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subdomain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+					resource "cpanel_subdomain" "subdomain" {
+						subdomain = "tf-acc-subdomain"
+						domain = "bolo8774.odns.fr"
+						document_root = "public_html/tf-acc-subdomain-updated"
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("cpanel_subdomain.subdomain", "document_root", "public_html/tf-acc-subdomain-updated"),
+			},
+		},
+	})
+}
+
+func TestSubdomainResource(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:                 func() { testUnitPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_subdomain" "subdomain" {
+						subdomain = "blog"
+						domain = "example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_subdomain.subdomain", "document_root", "public_html/blog"),
+					resource.TestCheckResourceAttrSet("cpanel_subdomain.subdomain", "last_updated"),
+					checkSubdomain(server, "blog.example.com", "public_html/blog"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "cpanel_subdomain.subdomain",
+				ImportStateId:                        "blog.example.com",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subdomain",
+				ImportStateVerifyIgnore:              []string{"last_updated"},
+			},
+			// Drift testing
+			{
+				PreConfig: func() { server.AddSubdomain("blog", "example.com", "blog") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_subdomain" "subdomain" {
+						subdomain = "blog"
+						domain = "example.com"
+						document_root = "public_html/blog"
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update document root testing
+			{
+				Config: server.ProviderConfig() + `
+					resource "cpanel_subdomain" "subdomain" {
+						subdomain = "blog"
+						domain = "example.com"
+						document_root = "sites/blog"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cpanel_subdomain.subdomain", "document_root", "sites/blog"),
+					checkSubdomain(server, "blog.example.com", "sites/blog"),
+				),
+			},
+			// Re-create after deletion outside Terraform
+			{
+				PreConfig: func() { server.DeleteSubdomain("blog.example.com") },
+				Config: server.ProviderConfig() + `
+					resource "cpanel_subdomain" "subdomain" {
+						subdomain = "blog"
+						domain = "example.com"
+						document_root = "sites/blog"
+					}
+				`,
+				Check: checkSubdomain(server, "blog.example.com", "sites/blog"),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if server.Subdomain("blog.example.com") != nil {
+				return fmt.Errorf("expected the subdomain blog.example.com to be deleted")
+			}
+			return nil
+		},
+	})
+}
+
+func TestSubdomainResourceReadRemovesDeletedSubdomain(t *testing.T) {
+	server := cpaneltest.NewServer(t)
+	server.AddSubdomain("shop", "example.com", "public_html/shop")
+
+	r := &subdomainResource{client: domain.NewClient(newTestClient(t, server))}
+	state := readResource(t, r, &SubdomainModel{
+		Subdomain:    types.StringValue("blog"),
+		Domain:       types.StringValue("example.com"),
+		DocumentRoot: types.StringValue("public_html/blog"),
+		LastUpdated:  types.StringValue("2024-01-01T00:00:00Z"),
+	})
+
+	if !state.Raw.IsNull() {
+		t.Fatal("expected the deleted subdomain to be removed from the state")
+	}
+}
+
+func checkSubdomain(server *cpaneltest.Server, fqdn, expectedDir string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		subdomain := server.Subdomain(fqdn)
+		if subdomain == nil {
+			return fmt.Errorf("expected the subdomain %s to exist", fqdn)
+		}
+		if subdomain.Dir != expectedDir {
+			return fmt.Errorf("expected the document root of %s to be %q, got %q", fqdn, expectedDir, subdomain.Dir)
+		}
+		return nil
+	}
+}